@@ -159,7 +159,8 @@ var (
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler, interchainstakingclient.RegisterProposalHandler,
-			interchainstakingclient.UpdateProposalHandler,
+			interchainstakingclient.UpdateProposalHandler, interchainstakingclient.DeregisterProposalHandler,
+			interchainstakingclient.ResumeProposalHandler,
 			// Custom proposal types
 		),
 		params.AppModuleBasic{},
@@ -425,10 +426,12 @@ func NewQuicksilver(
 		keys[interchainstakingtypes.StoreKey],
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
 		app.ICAControllerKeeper,
 		scopedInterchainStakingKeeper,
 		app.InterchainQueryKeeper,
 		*app.IBCKeeper,
+		app.TransferKeeper,
 		app.GetSubspace(interchainstakingtypes.ModuleName),
 	)
 	interchainstakingModule := interchainstaking.NewAppModule(appCodec, app.InterchainstakingKeeper)
@@ -482,7 +485,7 @@ func NewQuicksilver(
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.
-		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+		AddRoute(ibctransfertypes.ModuleName, interchainstaking.NewTransferMiddleware(transferIBCModule, app.InterchainstakingKeeper)).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
 		AddRoute(interchainstakingtypes.ModuleName, icaControllerIBCModule)
@@ -667,33 +670,33 @@ func NewQuicksilver(
 	// // add test gRPC service for testing gRPC queries in isolation
 	// // testdata.RegisterTestServiceServer(app.GRPCQueryRouter(), testdata.TestServiceImpl{})
 
-	// // create the simulation manager and define the order of the modules for deterministic simulations
-
-	// // NOTE: this is not required apps that don't use the simulator for fuzz testing
-	// // transactions
-	// app.sm = module.NewSimulationManager(
-	// 	auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-	// 	bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
-	// 	capability.NewAppModule(appCodec, *app.CapabilityKeeper),
-	// 	gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
-	// 	mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
-	// 	staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
-	// 	distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-	// 	slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-	// 	params.NewAppModule(app.ParamsKeeper),
-	// 	evidence.NewAppModule(app.EvidenceKeeper),
-	// 	feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-	// 	authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
-	// 	// ibc modules
-	// 	ibc.NewAppModule(app.IBCKeeper),
-	// 	transferModule,
-	// 	//icaModule,
-	// 	// Quicksilver app modules
-	// 	epochs.NewAppModule(appCodec, app.EpochsKeeper),
-	// 	interchainstakingModule,
-	// )
-
-	// app.sm.RegisterStoreDecoders()
+	// create the simulation manager and define the order of the modules for deterministic simulations
+
+	// NOTE: this is not required apps that don't use the simulator for fuzz testing
+	// transactions; bank must precede interchainstaking, which credits qAssets to the bank genesis.
+	// mint is omitted, as it does not implement AppModuleSimulation; its genesis defaults are used.
+	app.sm = module.NewSimulationManager(
+		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
+		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
+		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		distrSimulationModule{distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper)},
+		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		params.NewAppModule(app.ParamsKeeper),
+		evidence.NewAppModule(app.EvidenceKeeper),
+		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
+		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		// ibc modules
+		ibc.NewAppModule(app.IBCKeeper),
+		transferModule,
+		// Quicksilver app modules
+		epochs.NewAppModule(appCodec, app.EpochsKeeper),
+		interchainstakingModule,
+		interchainQueryModule,
+	)
+
+	app.sm.RegisterStoreDecoders()
 
 	// initialize stores
 	app.MountKVStores(keys)
@@ -0,0 +1,186 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// AppStateFn returns the initial application state using a genesis or the simulation parameters. It is
+// simapp.AppStateFn, but starting from Quicksilver's default genesis, so that modules outside the simulation
+// manager are initialised with their defaults.
+func AppStateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simtypes.AppStateFn {
+	return func(r *rand.Rand, accs []simtypes.Account, config simtypes.Config,
+	) (appState json.RawMessage, simAccs []simtypes.Account, chainID string, genesisTimestamp time.Time) {
+		if simapp.FlagGenesisTimeValue == 0 {
+			genesisTimestamp = simtypes.RandTimestamp(r)
+		} else {
+			genesisTimestamp = time.Unix(simapp.FlagGenesisTimeValue, 0)
+		}
+
+		chainID = config.ChainID
+		switch {
+		case config.ParamsFile != "" && config.GenesisFile != "":
+			panic("cannot provide both a genesis file and a params file")
+
+		case config.GenesisFile != "":
+			genesisDoc, accounts := simapp.AppStateFromGenesisFileFn(r, cdc, config.GenesisFile)
+			if simapp.FlagGenesisTimeValue == 0 {
+				genesisTimestamp = genesisDoc.GenesisTime
+			}
+			appState = genesisDoc.AppState
+			chainID = genesisDoc.ChainID
+			simAccs = accounts
+
+		case config.ParamsFile != "":
+			appParams := make(simtypes.AppParams)
+			bz, err := ioutil.ReadFile(config.ParamsFile)
+			if err != nil {
+				panic(err)
+			}
+			if err := json.Unmarshal(bz, &appParams); err != nil {
+				panic(err)
+			}
+			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams)
+
+		default:
+			appParams := make(simtypes.AppParams)
+			appState, simAccs = AppStateRandomizedFn(simManager, r, cdc, accs, genesisTimestamp, appParams)
+		}
+
+		return withNotBondedPool(cdc, appState), simAccs, chainID, genesisTimestamp
+	}
+}
+
+// AppStateRandomizedFn creates calls each module's GenesisState generator function and creates the simulation
+// params.
+func AppStateRandomizedFn(
+	simManager *module.SimulationManager, r *rand.Rand, cdc codec.JSONCodec,
+	accs []simtypes.Account, genesisTimestamp time.Time, appParams simtypes.AppParams,
+) (json.RawMessage, []simtypes.Account) {
+	numAccs := int64(len(accs))
+	genesisState := NewDefaultGenesisState()
+
+	// generate a random amount of initial stake coins and a random initial number of bonded accounts
+	var initialStake, numInitiallyBonded int64
+	appParams.GetOrGenerate(
+		cdc, simappparams.StakePerAccount, &initialStake, r,
+		func(r *rand.Rand) { initialStake = r.Int63n(1e12) },
+	)
+	appParams.GetOrGenerate(
+		cdc, simappparams.InitiallyBondedValidators, &numInitiallyBonded, r,
+		func(r *rand.Rand) { numInitiallyBonded = int64(r.Intn(300)) },
+	)
+
+	if numInitiallyBonded > numAccs {
+		numInitiallyBonded = numAccs
+	}
+
+	fmt.Printf(
+		`Selected randomly generated parameters for simulated genesis:
+{
+  stake_per_account: "%d",
+  initially_bonded_validators: "%d"
+}
+`, initialStake, numInitiallyBonded,
+	)
+
+	simState := &module.SimulationState{
+		AppParams:    appParams,
+		Cdc:          cdc,
+		Rand:         r,
+		GenState:     genesisState,
+		Accounts:     accs,
+		InitialStake: initialStake,
+		NumBonded:    numInitiallyBonded,
+		GenTimestamp: genesisTimestamp,
+	}
+
+	simManager.GenerateGenesisStates(simState)
+
+	appState, err := json.Marshal(genesisState)
+	if err != nil {
+		panic(err)
+	}
+
+	return appState, accs
+}
+
+// withNotBondedPool credits the tokens of unbonded genesis validators to the not bonded pool.
+func withNotBondedPool(cdc codec.JSONCodec, appState json.RawMessage) json.RawMessage {
+	rawState := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(appState, &rawState); err != nil {
+		panic(err)
+	}
+
+	stakingStateBz, ok := rawState[stakingtypes.ModuleName]
+	if !ok {
+		panic("staking genesis state is missing")
+	}
+	stakingState := new(stakingtypes.GenesisState)
+	cdc.MustUnmarshalJSON(stakingStateBz, stakingState)
+
+	notBondedTokens := sdk.ZeroInt()
+	for _, val := range stakingState.Validators {
+		if val.Status != stakingtypes.Unbonded {
+			continue
+		}
+		notBondedTokens = notBondedTokens.Add(val.GetTokens())
+	}
+	notBondedCoins := sdk.NewCoin(stakingState.Params.BondDenom, notBondedTokens)
+
+	bankStateBz, ok := rawState[banktypes.ModuleName]
+	if !ok {
+		panic("bank genesis state is missing")
+	}
+	bankState := new(banktypes.GenesisState)
+	cdc.MustUnmarshalJSON(bankStateBz, bankState)
+
+	stakingAddr := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+	var found bool
+	for _, balance := range bankState.Balances {
+		if balance.Address == stakingAddr {
+			found = true
+			break
+		}
+	}
+	if !found {
+		bankState.Balances = append(bankState.Balances, banktypes.Balance{
+			Address: stakingAddr,
+			Coins:   sdk.NewCoins(notBondedCoins),
+		})
+	}
+
+	rawState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingState)
+	rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankState)
+
+	appState, err := json.Marshal(rawState)
+	if err != nil {
+		panic(err)
+	}
+	return appState
+}
+
+// distrSimulationModule is the distribution module without governance proposal contents for simulation, as the
+// community pool spend proposal type is not registered with gov by the SDK fork, so its proposals are rejected.
+type distrSimulationModule struct {
+	distr.AppModule
+}
+
+// ProposalContents returns no content functions for governance proposals.
+func (distrSimulationModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent {
+	return nil
+}
@@ -24,6 +24,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 			CurrentEpochStartTime:   time.Time{},
 			EpochCountingStarted:    false,
 		},
+		{
+			// interchainstaking epochs; see x/interchainstaking/keeper/hooks.go.
+			Identifier:              "epoch",
+			StartTime:               time.Time{},
+			Duration:                time.Hour * 6,
+			CurrentEpoch:            0,
+			CurrentEpochStartHeight: 0,
+			CurrentEpochStartTime:   time.Time{},
+			EpochCountingStarted:    false,
+		},
 		{
 			Identifier:              "hour",
 			StartTime:               time.Time{},
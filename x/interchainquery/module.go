@@ -20,7 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
-
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/simulation"
 	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
 )
 
@@ -173,9 +173,9 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 
 // AppModuleSimulation functions
 
-// GenerateGenesisState creates a randomized GenState of the pool-incentives module.
+// GenerateGenesisState creates a randomized GenState of the interchainquery module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	// simulation.RandomizedGenState(simState)
+	simulation.RandomizedGenState(simState)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals.
@@ -192,9 +192,11 @@ func (AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
 func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
 }
 
-// WeightedOperations returns the all the gov module operations with their respective weights.
+// WeightedOperations returns the all the interchainquery module operations with their respective weights. Query
+// responses can only be simulated by the modules that own the queries, which stand in for the host chain; see
+// simulation.SimulateMsgSubmitQueryResponse.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	return nil // TODO
+	return nil
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
@@ -0,0 +1,26 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// RandomizedGenState generates a GenesisState for interchainquery. Queries are registered by the modules that
+// own them as the simulation progresses, so genesis starts without any.
+func RandomizedGenState(simState *module.SimulationState) {
+	icqGenesis := types.DefaultGenesis()
+
+	bz, err := json.MarshalIndent(icqGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected deterministically generated interchainquery parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(icqGenesis)
+}
@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+)
+
+// QueryResponder stands in for a host chain, returning the response to a pending query. Queries it cannot answer,
+// such as those requiring a proof of inclusion, are reported as not ok.
+type QueryResponder func(ctx sdk.Context, query types.Query) (result []byte, height int64, ok bool)
+
+// SimulateMsgSubmitQueryResponse answers a random pending query using the given responder, as a relayer would.
+func SimulateMsgSubmitQueryResponse(ak simulation.AccountKeeper, bk simulation.BankKeeper, k keeper.Keeper, responder QueryResponder) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var answerable []types.Query
+		var results [][]byte
+		var heights []int64
+		k.IterateQueries(ctx, func(_ int64, query types.Query) bool {
+			if result, height, ok := responder(ctx, query); ok {
+				answerable = append(answerable, query)
+				results = append(results, result)
+				heights = append(heights, height)
+			}
+			return false
+		})
+
+		if len(answerable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitQueryResponse, "no answerable queries"), nil, nil
+		}
+
+		i := r.Intn(len(answerable))
+		relayer, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgSubmitQueryResponse{
+			ChainId:     answerable[i].ChainId,
+			QueryId:     answerable[i].Id,
+			Result:      results[i],
+			Height:      heights[i],
+			FromAddress: relayer.Address.String(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Msg:           msg,
+			MsgType:       msg.Type(),
+			Context:       ctx,
+			SimAccount:    relayer,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		// responses are only served for queries the responder can answer from current state, e.g. of zones that
+		// are still registered, so a rejected response is a fault in the callback and fails the simulation.
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -2,6 +2,8 @@ package cli_test
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -341,6 +343,29 @@ func (s *IntegrationTestSuite) TestGetSignalIntentTxCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetBuildMemoCmd() {
+	intents := "0.3cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0,0.7cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"
+	parsed, err := types.IntentsFromString(intents)
+	s.Require().NoError(err)
+	expected, err := types.EncodeMemo(parsed, nil, "partner")
+	s.Require().NoError(err)
+
+	// the memo is written to stdout, so that it can be captured by scripts.
+	r, w, err := os.Pipe()
+	s.Require().NoError(err)
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	cmd := cli.GetBuildMemoCmd()
+	cmd.SetArgs([]string{intents, fmt.Sprintf("--%s=partner", cli.FlagReferral)})
+	s.Require().NoError(cmd.Execute())
+	s.Require().NoError(w.Close())
+	out, err := io.ReadAll(r)
+	s.Require().NoError(err)
+	s.Require().Equal(expected+"\n", string(out))
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -12,6 +12,11 @@ import (
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
 
+const (
+	FlagDelegator = "delegator"
+	FlagStatus    = "status"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	// Group epochs queries under a subcommand
@@ -28,6 +33,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdZonesInfos(),
 		GetDelegatorIntentCmd(),
 		GetDepositAccountCmd(),
+		GetZoneFeesCmd(),
+		GetRefundsCmd(),
+		GetReceiptsCmd(),
+		GetReceiptCmd(),
+		GetSlashingIncidentsCmd(),
+		GetIcaOperationsCmd(),
+		GetWithdrawalRecordsCmd(),
 	)
 
 	return cmd
@@ -145,3 +157,366 @@ func GetDepositAccountCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetZoneFeesCmd returns the cumulative fees collected for the given chainID
+// (zone).
+func GetZoneFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fees [chain_id]",
+		Short: "Query cumulative fees collected for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryZoneFeesRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.ZoneFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRefundsCmd returns the refunds of rejected deposits for the given chainID
+// (zone).
+func GetRefundsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refunds [chain_id] [recipient]",
+		Short: "Query refunds of rejected deposits for a given chain, optionally filtered by recipient.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			recipient := ""
+			if len(args) > 1 {
+				recipient = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRefundsRequest{
+				ChainId:    chainID,
+				Recipient:  recipient,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Refunds(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "refunds")
+
+	return cmd
+}
+
+// GetReceiptsCmd returns the deposit receipts for the given chainID (zone).
+func GetReceiptsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receipts [chain_id] [sender]",
+		Short: "Query deposit receipts for a given chain, optionally filtered by sender.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			sender := ""
+			if len(args) > 1 {
+				sender = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryReceiptsRequest{
+				ChainId:    chainID,
+				Sender:     sender,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Receipts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "receipts")
+
+	return cmd
+}
+
+// GetReceiptCmd returns the deposit receipt of the given transaction for the
+// given chainID (zone).
+func GetReceiptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receipt [chain_id] [txhash]",
+		Short: "Query the deposit receipt of a transaction for a given chain.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			txhash := args[1]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryReceiptRequest{
+				ChainId: chainID,
+				Txhash:  txhash,
+			}
+
+			res, err := queryClient.Receipt(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSlashingIncidentsCmd returns the detected slashes of validators for the
+// given chainID (zone).
+func GetSlashingIncidentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-incidents [chain_id] [validator]",
+		Short: "Query detected validator slashes for a given chain, optionally filtered by validator.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// args
+			chainID := args[0]
+			validator := ""
+			if len(args) > 1 {
+				validator = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QuerySlashingIncidentsRequest{
+				ChainId:    chainID,
+				Validator:  validator,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SlashingIncidents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slashing-incidents")
+
+	return cmd
+}
+
+// GetIcaOperationsCmd returns the unresolved interchain account operations of
+// the given zone.
+func GetIcaOperationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-operations [chain_id]",
+		Short: "Query pending, failed and timed out interchain account operations for a given chain, optionally filtered by status.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainstaking ica-operations cosmoshub-4 --status=failed,timedout`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			statusStrs, err := cmd.Flags().GetStringSlice(FlagStatus)
+			if err != nil {
+				return err
+			}
+			statuses := make([]types.IcaOperationStatus, 0, len(statusStrs))
+			for _, statusStr := range statusStrs {
+				status, err := parseIcaOperationStatus(statusStr)
+				if err != nil {
+					return err
+				}
+				statuses = append(statuses, status)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryIcaOperationsRequest{
+				ChainId:    args[0],
+				Pagination: pageReq,
+				Statuses:   statuses,
+			}
+
+			res, err := queryClient.IcaOperations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagStatus, nil, "filter by comma separated statuses (pending, failed or timedout)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ica-operations")
+
+	return cmd
+}
+
+// GetWithdrawalRecordsCmd returns the withdrawal records of redemptions from
+// the given zone.
+func GetWithdrawalRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawal-records [chain_id]",
+		Short: "Query withdrawal records of redemptions for a given chain, optionally filtered by delegator, recipient and status.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainstaking withdrawal-records cosmoshub-4 --recipient=cosmos1... --status=unbond`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			delegator, err := cmd.Flags().GetString(FlagDelegator)
+			if err != nil {
+				return err
+			}
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+			statusStr, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			status, err := parseWithdrawalRecordStatus(statusStr)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryWithdrawalRecordsRequest{
+				ChainId:    args[0],
+				Delegator:  delegator,
+				Recipient:  recipient,
+				Status:     status,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.WithdrawalRecords(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDelegator, "", "filter by delegation account address")
+	cmd.Flags().String(FlagRecipient, "", "filter by recipient address")
+	cmd.Flags().String(FlagStatus, "", "filter by status (tokenize, send, queued, unbond, completed or failed)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "withdrawal-records")
+
+	return cmd
+}
+
+// parseWithdrawalRecordStatus parses a withdrawal record status, given either
+// as its enum name or without the WithdrawStatus prefix; empty is unspecified.
+func parseWithdrawalRecordStatus(s string) (types.WithdrawalRecordStatus, error) {
+	if s == "" {
+		return types.WithdrawStatusUnspecified, nil
+	}
+	for name, value := range types.WithdrawalRecordStatus_value {
+		if strings.EqualFold(s, name) || strings.EqualFold(s, strings.TrimPrefix(name, "WithdrawStatus")) {
+			return types.WithdrawalRecordStatus(value), nil
+		}
+	}
+	return types.WithdrawStatusUnspecified, fmt.Errorf("invalid withdrawal record status %q", s)
+}
+
+// parseIcaOperationStatus parses an interchain account operation status, given
+// either as its enum name or without the IcaOperation prefix.
+func parseIcaOperationStatus(s string) (types.IcaOperationStatus, error) {
+	for name, value := range types.IcaOperationStatus_value {
+		if strings.EqualFold(s, name) || strings.EqualFold(s, strings.TrimPrefix(name, "IcaOperation")) {
+			return types.IcaOperationStatus(value), nil
+		}
+	}
+	return types.IcaOperationPending, fmt.Errorf("invalid interchain account operation status %q", s)
+}
@@ -17,6 +17,11 @@ import (
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
 
+const (
+	FlagRecipient = "recipient"
+	FlagReferral  = "referral"
+)
+
 // GetTxCmd returns a root CLI command handler for all x/bank transaction commands.
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -30,6 +35,7 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(GetSignalIntentTxCmd())
 	txCmd.AddCommand(GetRequestRedemptionTxCmd())
+	txCmd.AddCommand(GetBuildMemoCmd())
 
 	return txCmd
 }
@@ -67,6 +73,59 @@ e.g. "0.3cosmosvaloper1xxxxxxxxx,0.3cosmosvaloper1yyyyyyyyy,0.4cosmosvaloper1zzz
 	return cmd
 }
 
+// GetBuildMemoCmd returns a CLI command handler for building a deposit memo.
+func GetBuildMemoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-memo [delegation_intent]",
+		Short: `Build a deposit memo.`,
+		Long: `build a versioned deposit memo, to be set on deposits to a zone deposit account,
+or as the intent of IBC deposits. The delegation intent is a comma separated string
+containing a decimal weight and the bech32 validator address, with weights summing
+to one in increments of 0.005. An empty string sets no intent.`,
+		Example: `build-memo 0.3cosmosvaloper1xxxxxxxxx,0.7cosmosvaloper1yyyyyyyyy --recipient quick1zzzzzzzzz --referral partner`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			intents := []*types.ValidatorIntent{}
+			if args[0] != "" {
+				var err error
+				intents, err = types.IntentsFromString(args[0])
+				if err != nil {
+					return fmt.Errorf("%v, see example: %v", err, cmd.Example)
+				}
+			}
+
+			recipientStr, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+			var recipient sdk.AccAddress
+			if recipientStr != "" {
+				recipient, err = sdk.AccAddressFromBech32(recipientStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			referral, err := cmd.Flags().GetString(FlagReferral)
+			if err != nil {
+				return err
+			}
+
+			memo, err := types.EncodeMemo(intents, recipient, referral)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), memo)
+			return err
+		},
+	}
+	cmd.Flags().String(FlagRecipient, "", "the recipient of the minted qAssets on Quicksilver")
+	cmd.Flags().String(FlagReferral, "", "the referral tag of the deposit")
+
+	return cmd
+}
+
 // GetRegisterZoneTxCmd returns a CLI command handler for creating a MsgSend transaction.
 func GetRequestRedemptionTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -173,7 +232,7 @@ func ParseZoneRegistrationProposal(cdc codec.JSONCodec, proposalFile string) (ty
 	return proposal, nil
 }
 
-// GetCmdSubmitRegisterProposal implements the command to submit a register-zone proposal
+// GetCmdSubmitUpdateProposal implements the command to submit an update-zone proposal
 func GetCmdSubmitUpdateProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-zone [proposal-file]",
@@ -183,7 +242,15 @@ func GetCmdSubmitUpdateProposal() *cobra.Command {
 			`Submit a zone update proposal along with an initial deposit.
 The proposal details must be supplied via a JSON file.
 Example:
-$ %s tx gov submit-proposal register-zone <path/to/proposal.json> --from=<key_or_address>
+$ %s tx gov submit-proposal update-zone <path/to/proposal.json> --from=<key_or_address>
+Supported keys are base_denom, multi_send, liquidity_module, account_prefix,
+validator_selection_allocation, holdings_allocation,
+commission_rate, max_redemption_rate_change, min_redemption_rate,
+max_redemption_rate, state (one of active, deposits_paused,
+redemptions_paused or paused), validator_denylist and validator_allowlist
+(comma separated validator operator addresses; empty to clear) and
+delegation_account_count (which may only be reduced for zones with the
+liquidity module, as retired accounts are drained by tokenizing shares).
 Where proposal.json contains:
 {
   "title": "Enable liquidity module for cosmoshub-4",
@@ -191,7 +258,7 @@ Where proposal.json contains:
   "chain_id": "cosmoshub-4",
   "changes": [{
       "key": "liquidity_module",
-      "value": "true",
+      "value": "true"
   }],
   "deposit": "512000000uqck"
 }
@@ -241,3 +308,137 @@ func ParseZoneUpdateProposal(cdc codec.JSONCodec, proposalFile string) (types.Up
 
 	return proposal, nil
 }
+
+// GetCmdSubmitDeregisterProposal implements the command to submit a deregister-zone proposal
+func GetCmdSubmitDeregisterProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-zone [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a zone deregistration proposal",
+		Long: strings.TrimSpace(
+			`Submit a zone deregistration proposal along with an initial deposit.
+The zone stops accepting deposits and all of its delegations are unbonded;
+qAssets may be redeemed at the final redemption rate once unbonding completes.
+The proposal details must be supplied via a JSON file.
+Example:
+$ %s tx gov submit-proposal deregister-zone <path/to/proposal.json> --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Deregister cosmoshub-4",
+  "description": "Sunset the cosmoshub-4 zone",
+  "chain_id": "cosmoshub-4",
+  "deposit": "512000000uqck"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseZoneDeregistrationProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewDeregisterZoneProposal(proposal.Title, proposal.Description, proposal.ChainId)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func ParseZoneDeregistrationProposal(cdc codec.JSONCodec, proposalFile string) (types.DeregisterZoneProposalWithDeposit, error) {
+	proposal := types.DeregisterZoneProposalWithDeposit{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// GetCmdSubmitResumeProposal implements the command to submit a resume-zone proposal
+func GetCmdSubmitResumeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-zone [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a zone resume proposal",
+		Long: strings.TrimSpace(
+			`Submit a zone resume proposal along with an initial deposit.
+Lifts the pause on minting and redemptions set when a redemption rate update
+breached the zone's bounds. Bounds may be adjusted with an update-zone proposal.
+The proposal details must be supplied via a JSON file.
+Example:
+$ %s tx gov submit-proposal resume-zone <path/to/proposal.json> --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Resume cosmoshub-4",
+  "description": "Resume minting and redemptions for cosmoshub-4",
+  "chain_id": "cosmoshub-4",
+  "deposit": "512000000uqck"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseZoneResumeProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewResumeZoneProposal(proposal.Title, proposal.Description, proposal.ChainId)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+func ParseZoneResumeProposal(cdc codec.JSONCodec, proposalFile string) (types.ResumeZoneProposalWithDeposit, error) {
+	proposal := types.ResumeZoneProposalWithDeposit{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -13,8 +13,10 @@ import (
 
 // ProposalHandler is the community spend proposal handler.
 var (
-	RegisterProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitRegisterProposal, emptyRestHandler)
-	UpdateProposalHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateProposal, emptyRestHandler)
+	RegisterProposalHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitRegisterProposal, emptyRestHandler)
+	UpdateProposalHandler     = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateProposal, emptyRestHandler)
+	DeregisterProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitDeregisterProposal, emptyRestHandler)
+	ResumeProposalHandler     = govclient.NewProposalHandler(cli.GetCmdSubmitResumeProposal, emptyRestHandler)
 )
 
 func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
@@ -1,6 +1,8 @@
 package interchainstaking
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
@@ -14,8 +16,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// set registered zones info from genesis
 	for _, zone := range genState.Zones {
-		// TODO: instantiate connections to ICAs based upon genesis values
 		k.SetRegisteredZone(ctx, zone)
+		// re-bind the zone's interchain account ports, so existing channels remain usable.
+		if err := k.RestoreICAAccounts(ctx, zone); err != nil {
+			panic(fmt.Sprintf("unable to restore interchain accounts for zone %s: %v", zone.ChainId, err))
+		}
 	}
 
 	for _, pc := range genState.PortConnections {
@@ -48,25 +53,69 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic("unable to find zone for delegation")
 		}
 		for _, delegatorIntent := range delegatorIntentsForZone.DelegationIntent {
-			k.SetIntent(ctx, zone, *delegatorIntent, false)
+			k.SetIntent(ctx, zone, *delegatorIntent, delegatorIntentsForZone.Snapshot)
 		}
 	}
 
 	for _, receipt := range genState.Receipts {
 		k.SetReceipt(ctx, receipt)
 	}
+
+	for i := range genState.WithdrawalRecords {
+		k.SetWithdrawalRecord(ctx, &genState.WithdrawalRecords[i])
+	}
+
+	for _, refund := range genState.Refunds {
+		k.SetRefund(ctx, refund)
+	}
+
+	for _, deposit := range genState.IbcDeposits {
+		k.SetIBCDeposit(ctx, deposit)
+	}
+
+	for _, incident := range genState.SlashingIncidents {
+		k.SetSlashingIncident(ctx, incident)
+	}
+
+	for _, operation := range genState.IcaOperations {
+		k.SetIcaOperation(ctx, operation)
+	}
+
+	for _, zoneFees := range genState.ZoneFees {
+		for _, fee := range zoneFees.Fees {
+			k.AddZoneFees(ctx, zoneFees.ChainId, fee)
+		}
+	}
+
+	for _, escrow := range genState.RedemptionEscrows {
+		k.SetRedemptionEscrow(ctx, escrow)
+	}
+
+	for _, pass := range genState.ValsetPasses {
+		for _, valoper := range pass.Validators {
+			k.SetValsetPassValidator(ctx, pass.ChainId, pass.Status, valoper)
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:           k.GetParams(ctx),
-		Zones:            k.AllRegisteredZones(ctx),
-		Receipts:         k.AllReceipts(ctx),
-		Delegations:      ExportDelegationsPerZone(ctx, k),
-		DelegationPlans:  ExportDelegationPlansPerZone(ctx, k),
-		DelegatorIntents: ExportDelegatorIntentsPerZone(ctx, k),
-		PortConnections:  k.AllPortConnections(ctx),
+		Params:            k.GetParams(ctx),
+		Zones:             k.AllRegisteredZones(ctx),
+		Receipts:          k.AllReceipts(ctx),
+		Delegations:       ExportDelegationsPerZone(ctx, k),
+		DelegationPlans:   ExportDelegationPlansPerZone(ctx, k),
+		DelegatorIntents:  ExportDelegatorIntentsPerZone(ctx, k),
+		PortConnections:   k.AllPortConnections(ctx),
+		WithdrawalRecords: k.AllWithdrawalRecords(ctx, ""),
+		Refunds:           ExportRefundsPerZone(ctx, k),
+		IbcDeposits:       ExportIBCDeposits(ctx, k),
+		SlashingIncidents: ExportSlashingIncidentsPerZone(ctx, k),
+		IcaOperations:     ExportIcaOperationsPerZone(ctx, k),
+		ZoneFees:          ExportZoneFees(ctx, k),
+		RedemptionEscrows: ExportRedemptionEscrowsPerZone(ctx, k),
+		ValsetPasses:      ExportValsetPassesPerZone(ctx, k),
 	}
 }
 
@@ -99,3 +148,68 @@ func ExportDelegatorIntentsPerZone(ctx sdk.Context, k keeper.Keeper) []types.Del
 	})
 	return delegatorIntentsForZones
 }
+
+func ExportRefundsPerZone(ctx sdk.Context, k keeper.Keeper) []types.Refund {
+	refunds := make([]types.Refund, 0)
+	k.IterateRegisteredZones(ctx, func(_ int64, zoneInfo types.RegisteredZone) (stop bool) {
+		refunds = append(refunds, k.AllRefunds(ctx, zoneInfo.ChainId)...)
+		return false
+	})
+	return refunds
+}
+
+func ExportIBCDeposits(ctx sdk.Context, k keeper.Keeper) []types.IBCDeposit {
+	deposits := make([]types.IBCDeposit, 0)
+	k.IterateIBCDeposits(ctx, func(_ int64, deposit types.IBCDeposit) (stop bool) {
+		deposits = append(deposits, deposit)
+		return false
+	})
+	return deposits
+}
+
+func ExportSlashingIncidentsPerZone(ctx sdk.Context, k keeper.Keeper) []types.SlashingIncident {
+	incidents := make([]types.SlashingIncident, 0)
+	k.IterateRegisteredZones(ctx, func(_ int64, zoneInfo types.RegisteredZone) (stop bool) {
+		incidents = append(incidents, k.AllSlashingIncidents(ctx, zoneInfo.ChainId)...)
+		return false
+	})
+	return incidents
+}
+
+func ExportIcaOperationsPerZone(ctx sdk.Context, k keeper.Keeper) []types.IcaOperation {
+	operations := make([]types.IcaOperation, 0)
+	k.IterateRegisteredZones(ctx, func(_ int64, zoneInfo types.RegisteredZone) (stop bool) {
+		operations = append(operations, k.AllIcaOperations(ctx, zoneInfo.ChainId)...)
+		return false
+	})
+	return operations
+}
+
+func ExportRedemptionEscrowsPerZone(ctx sdk.Context, k keeper.Keeper) []types.RedemptionEscrow {
+	escrows := make([]types.RedemptionEscrow, 0)
+	k.IterateRegisteredZones(ctx, func(_ int64, zoneInfo types.RegisteredZone) (stop bool) {
+		escrows = append(escrows, k.AllRedemptionEscrows(ctx, zoneInfo.ChainId)...)
+		return false
+	})
+	return escrows
+}
+
+func ExportValsetPassesPerZone(ctx sdk.Context, k keeper.Keeper) []types.ValsetPass {
+	passes := make([]types.ValsetPass, 0)
+	k.IterateRegisteredZones(ctx, func(_ int64, zoneInfo types.RegisteredZone) (stop bool) {
+		passes = append(passes, k.AllValsetPasses(ctx, zoneInfo.ChainId)...)
+		return false
+	})
+	return passes
+}
+
+func ExportZoneFees(ctx sdk.Context, k keeper.Keeper) []types.ZoneFees {
+	zoneFees := make([]types.ZoneFees, 0)
+	k.IterateRegisteredZones(ctx, func(_ int64, zoneInfo types.RegisteredZone) (stop bool) {
+		if fees := k.GetZoneFees(ctx, zoneInfo.ChainId); !fees.IsZero() {
+			zoneFees = append(zoneFees, types.ZoneFees{ChainId: zoneInfo.ChainId, Fees: fees})
+		}
+		return false
+	})
+	return zoneFees
+}
@@ -26,6 +26,10 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return keeper.HandleRegisterZoneProposal(ctx, k, c)
 		case *types.UpdateZoneProposal:
 			return keeper.HandleUpdateZoneProposal(ctx, k, c)
+		case *types.DeregisterZoneProposal:
+			return keeper.HandleDeregisterZoneProposal(ctx, k, c)
+		case *types.ResumeZoneProposal:
+			return keeper.HandleResumeZoneProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized interchainstaking proposal content type: %T", c)
@@ -104,6 +104,12 @@ func (im IBCModule) OnChanOpenAck(
 	}
 
 	ctx.Logger().Info("Found matching address", "chain", zoneInfo.ChainId, "address", address, "port", portID)
+
+	// channel re-opened after closure; the account is already known to the zone.
+	if account, err := zoneInfo.GetICAAccountByPortName(portID); err == nil && account.Unavailable {
+		return im.keeper.HandleReopenedICA(ctx, zoneInfo, portID)
+	}
+
 	portParts := strings.Split(portID, ".")
 
 	switch {
@@ -161,13 +167,17 @@ func (im IBCModule) OnChanOpenAck(
 			}
 		}
 		account := &types.ICAAccount{Address: address, Balance: sdk.Coins{}, DelegatedBalance: sdk.NewCoin(zoneInfo.BaseDenom, sdk.ZeroInt()), PortName: portID}
+		// the delegation account count may have been reduced while the account was being registered.
+		if index, err := account.DelegationIndex(); err == nil {
+			account.Retired = zoneInfo.IsRetiredDelegationIndex(index)
+		}
 		// append delegation account address
 		//nolint:gocritic
 		zoneInfo.DelegationAddresses = append(delegationAccounts, account)
 
 		// set withdrawal address if, and only if withdrawal address is already set
 		if zoneInfo.WithdrawalAddress != nil {
-			msg := distrTypes.MsgSetWithdrawAddress{DelegatorAddress: address, WithdrawAddress: zoneInfo.WithdrawalAddress.String()}
+			msg := distrTypes.MsgSetWithdrawAddress{DelegatorAddress: address, WithdrawAddress: zoneInfo.WithdrawalAddress.GetAddress()}
 			err := im.keeper.SubmitTx(ctx, []sdk.Msg{&msg}, account, "")
 			if err != nil {
 				return err
@@ -199,7 +209,7 @@ func (im IBCModule) registerPerformanceAddress(
 
 	// set withdrawal address if, and only if withdrawal address is already set
 	if zone.WithdrawalAddress != nil {
-		msg := distrTypes.MsgSetWithdrawAddress{DelegatorAddress: address, WithdrawAddress: zone.WithdrawalAddress.String()}
+		msg := distrTypes.MsgSetWithdrawAddress{DelegatorAddress: address, WithdrawAddress: zone.WithdrawalAddress.GetAddress()}
 		err := im.keeper.SubmitTx(ctx, []sdk.Msg{&msg}, zone.PerformanceAddress, "")
 		if err != nil {
 			return err
@@ -233,6 +243,9 @@ func (im IBCModule) OnChanCloseConfirm(
 	portID,
 	channelID string,
 ) error {
+	if err := im.keeper.SetICAAccountUnavailable(ctx, portID); err != nil {
+		ctx.Logger().Error("unable to mark interchain account unavailable", "port", portID, "error", err)
+	}
 	return nil
 }
 
@@ -271,6 +284,19 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
+	connectionID, _, err := im.keeper.IBCKeeper.ChannelKeeper.GetChannelConnection(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		err = fmt.Errorf("packet connection not found: %w", err)
+		ctx.Logger().Error(err.Error())
+		return err
+	}
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), connectionID))
+
+	// interchain account channels are ordered; a timeout closes the channel.
+	if err := im.keeper.SetICAAccountUnavailable(ctx, packet.SourcePort); err != nil {
+		ctx.Logger().Error("unable to mark interchain account unavailable", "port", packet.SourcePort, "error", err)
+	}
+
 	return im.keeper.HandleTimeout(ctx, packet)
 }
 
@@ -17,6 +17,9 @@ type zoneItrFn func(index int64, zoneInfo types.RegisteredZone) (stop bool)
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	k.IterateRegisteredZones(ctx, func(index int64, zone types.RegisteredZone) (stop bool) {
+		if !zone.IsAvailable() {
+			k.ReopenClosedICAChannels(ctx, zone)
+		}
 		connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, zone.ConnectionId)
 		if found {
 			consState, found := k.IBCKeeper.ClientKeeper.GetLatestClientConsensusState(ctx, connection.GetClientID())
@@ -56,6 +56,7 @@ func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 	a := c.
 		AddCallback("valset", Callback(ValsetCallback)).
 		AddCallback("validator", Callback(ValidatorCallback)).
+		AddCallback("signinginfo", Callback(SigningInfoCallback)).
 		AddCallback("rewards", Callback(RewardsCallback)).
 		AddCallback("delegations", Callback(DelegationsCallback)).
 		AddCallback("delegation", Callback(DelegationCallback)).
@@ -78,7 +79,11 @@ func ValsetCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query
 	if !found {
 		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
-	return SetValidatorsForZone(k, ctx, zone, args)
+	request := stakingtypes.QueryValidatorsRequest{}
+	if err := k.cdc.Unmarshal(query.Request, &request); err != nil {
+		return err
+	}
+	return SetValidatorsForZone(k, ctx, zone, args, request)
 }
 
 func ValidatorCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
@@ -87,9 +92,21 @@ func ValidatorCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Qu
 	if !found {
 		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
+	if len(args) == 0 {
+		// the validator no longer exists on the host chain.
+		return RemoveValidatorForZone(k, ctx, zone, query.Request)
+	}
 	return SetValidatorForZone(k, ctx, zone, args)
 }
 
+func SigningInfoCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	zone, found := k.GetRegisteredZoneInfo(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+	return SetSigningInfoForZone(k, ctx, zone, args)
+}
+
 func RewardsCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
 	zone, found := k.GetRegisteredZoneInfo(ctx, query.GetChainId())
 	if !found {
@@ -349,6 +366,12 @@ func DepositTx(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) err
 		return nil
 	}
 
+	_, found = k.GetRefund(ctx, zone.ChainId, res.GetTxResponse().TxHash)
+	if found {
+		k.Logger(ctx).Info("Found previously refunded tx. Ignoring.", "txhash", res.GetTxResponse().TxHash)
+		return nil
+	}
+
 	// validate proof
 	connection, _ := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, zone.ConnectionId)
 
@@ -161,6 +161,8 @@ func (k *Keeper) Delegate(ctx sdk.Context, zone types.RegisteredZone, account *t
 
 func (k Keeper) DeterminePlanForDelegation(ctx sdk.Context, zone types.RegisteredZone, amount sdk.Coins, delegator string, txhash string) (types.Allocations, error) {
 	bins := k.GetDelegationBinsMap(ctx, &zone)
+	// new delegations are only allocated to active delegation accounts.
+	accounts := k.GetActiveDelegationBinsMap(ctx, &zone)
 
 	sendPlan := types.Allocations{}
 
@@ -170,14 +172,17 @@ func (k Keeper) DeterminePlanForDelegation(ctx sdk.Context, zone types.Registere
 		if coin.Denom == zone.BaseDenom {
 			var valPlan types.ValidatorIntents
 			plan, found := k.GetIntent(ctx, zone, delegator, false)
-			if !found || len(plan.Intents) == 0 {
+			if found {
+				// intents for excluded validators are dropped; the remaining intents are renormalised.
+				valPlan = zone.FilterExcludedIntents(plan.ToValidatorIntents())
+			}
+			if len(valPlan) == 0 {
 				valPlan = zone.GetAggregateIntentOrDefault()
 				delPlan, err = types.DelegationPlanFromGlobalIntent(bins, zone, coin, valPlan)
 				if err != nil {
 					return types.Allocations{}, err
 				}
 			} else {
-				valPlan = plan.ToValidatorIntents()
 				delPlan = types.DelegationPlanFromUserIntent(zone, coin, valPlan) // TODO: does it make sense to do this? why don't we just use the global intent?
 				if err != nil {
 					return types.Allocations{}, err
@@ -191,7 +196,8 @@ func (k Keeper) DeterminePlanForDelegation(ctx sdk.Context, zone types.Registere
 		for _, allocation := range delPlan.Sorted() {
 			var delegatorAddress string
 			for _, coin := range allocation.Amount {
-				delegatorAddress, bins = bins.FindAccountForDelegation(allocation.Address, sdk.NewCoin(zone.BaseDenom, coin.Amount))
+				delegatorAddress, accounts = accounts.FindAccountForDelegation(allocation.Address, sdk.NewCoin(zone.BaseDenom, coin.Amount))
+				bins = bins.Allocate(delegatorAddress, sdk.Coins{sdk.Coin{Denom: allocation.Address, Amount: coin.Amount}})
 
 				delegationPlan := types.NewDelegationPlan(delegatorAddress, allocation.Address, sdk.NewCoins(coin))
 				sendPlan = sendPlan.Allocate(delegatorAddress, sdk.NewCoins(coin))
@@ -263,3 +269,15 @@ func (k *Keeper) GetDelegationBinsMap(ctx sdk.Context, zone *types.RegisteredZon
 
 	return out.Sorted()
 }
+
+// GetActiveDelegationBinsMap returns the delegation bins of the zone's delegation accounts that are not retired, to
+// which new delegations are allocated.
+func (k *Keeper) GetActiveDelegationBinsMap(ctx sdk.Context, zone *types.RegisteredZone) types.Allocations {
+	out := types.Allocations{}
+	for _, bin := range k.GetDelegationBinsMap(ctx, zone) {
+		if account, err := zone.GetDelegationAccountByAddress(bin.Address); err == nil && !account.Retired {
+			out = append(out, bin)
+		}
+	}
+	return out
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// DrainMemo is the memo of interchain transactions moving delegations from retired delegation accounts.
+const DrainMemo = "drain"
+
+// SetDelegationAccountCount sets the number of active delegation accounts of the zone. Delegation accounts below the
+// count that do not yet exist are registered, and existing accounts beyond the count are retired. Retired accounts are
+// drained by DrainRetiredDelegationAccounts, which requires the liquid staking module, so the count of other zones
+// may not be reduced below their existing accounts.
+func (k Keeper) SetDelegationAccountCount(ctx sdk.Context, zone *types.RegisteredZone, count uint32) error {
+	if !zone.LiquidityModule {
+		for _, account := range zone.DelegationAddresses {
+			index, err := account.DelegationIndex()
+			if err != nil {
+				continue
+			}
+			if !account.Retired && index >= int(count) {
+				return fmt.Errorf("zone %s does not have the liquidity module enabled, so delegation account %s cannot be retired", zone.ChainId, account.Address)
+			}
+		}
+	}
+
+	for i := 0; i < int(count); i++ {
+		portOwner := fmt.Sprintf("%s.delegate.%d", zone.ChainId, i)
+		portID, err := icatypes.NewControllerPortID(portOwner)
+		if err != nil {
+			return err
+		}
+		if _, found := k.ICAControllerKeeper.GetInterchainAccountAddress(ctx, zone.ConnectionId, portID); found {
+			continue
+		}
+		// the account is already being registered.
+		if k.hasPendingChannelHandshake(ctx, zone.ConnectionId, portID) {
+			continue
+		}
+		k.Logger(ctx).Info("Registering delegation account", "zone", zone.ChainId, "port", portID)
+		if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
+			return err
+		}
+	}
+
+	zone.DelegationAccountCount = count
+	for _, account := range zone.DelegationAddresses {
+		index, err := account.DelegationIndex()
+		if err != nil {
+			continue
+		}
+		account.Retired = zone.IsRetiredDelegationIndex(index)
+	}
+	return nil
+}
+
+// DrainRetiredDelegationAccounts moves the delegations of retired delegation accounts to active accounts, by
+// tokenizing the shares to an active account, which then redeems them. Redelegation cannot be used, as it only moves
+// stake between validators of the same delegator. Draining requires the liquid staking module, so only zones that
+// have it may retire accounts. Accounts with a drain in flight are skipped until it is acknowledged.
+func (k *Keeper) DrainRetiredDelegationAccounts(ctx sdk.Context, zone types.RegisteredZone) error {
+	if !zone.LiquidityModule || zone.Sunsetting {
+		return nil
+	}
+
+	active := k.GetActiveDelegationBinsMap(ctx, &zone)
+	if len(active) == 0 {
+		return nil
+	}
+
+	now := ctx.BlockTime().Unix()
+	count := 0
+	for _, account := range zone.GetDelegationAccounts() {
+		if !account.Retired || account.Unavailable {
+			continue
+		}
+		// delegations are only removed from the retired account once their tokenization is acknowledged.
+		if k.hasPendingIcaOperation(ctx, zone.ChainId, account.PortName, DrainMemo) {
+			continue
+		}
+
+		delegations := []types.Delegation{}
+		k.IterateAllDelegations(ctx, &zone, func(delegation types.Delegation) bool {
+			// delegations that are the destination of an incomplete redelegation cannot be tokenized.
+			if delegation.DelegationAddress == account.Address && delegation.RedelegationEnd <= now && delegation.Amount.IsPositive() {
+				delegations = append(delegations, delegation)
+			}
+			return false
+		})
+
+		msgs := []sdk.Msg{}
+		for _, delegation := range delegations {
+			if count >= types.MaxDrainsPerEpoch {
+				break
+			}
+			target := active.SmallestBin().Address
+			active = active.Allocate(target, sdk.Coins{sdk.NewCoin(delegation.ValidatorAddress, delegation.Amount.Amount)})
+			msgs = append(msgs, &stakingtypes.MsgTokenizeShares{
+				DelegatorAddress:    delegation.DelegationAddress,
+				ValidatorAddress:    delegation.ValidatorAddress,
+				Amount:              delegation.Amount,
+				TokenizedShareOwner: target,
+			})
+			count++
+		}
+
+		if len(msgs) == 0 {
+			continue
+		}
+		k.Logger(ctx).Info("Draining retired delegation account", "zone", zone.ChainId, "delegator", account.Address, "msgs", len(msgs))
+		if err := k.SubmitTx(ctx, msgs, account, DrainMemo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleDrainTokenizedShares removes the tokenized shares from the retired account's delegation record, and redeems
+// them from the active account that received them.
+func (k *Keeper) handleDrainTokenizedShares(ctx sdk.Context, msg *stakingtypes.MsgTokenizeShares, amount sdk.Coin) error {
+	zone := k.GetZoneForDelegateAccount(ctx, msg.DelegatorAddress)
+	if zone == nil {
+		return fmt.Errorf("unable to find zone for address %s", msg.DelegatorAddress)
+	}
+
+	if delegation, found := k.GetDelegation(ctx, zone, msg.DelegatorAddress, msg.ValidatorAddress); found {
+		remaining := delegation.Amount.Amount.Sub(sdk.MinInt(msg.Amount.Amount, delegation.Amount.Amount))
+		if err := k.UpdateDelegationRecordForAddress(ctx, msg.DelegatorAddress, msg.ValidatorAddress, sdk.NewCoin(zone.BaseDenom, remaining), zone, true); err != nil {
+			return err
+		}
+	}
+
+	owner, err := zone.GetDelegationAccountByAddress(msg.TokenizedShareOwner)
+	if err != nil {
+		return err
+	}
+	redeemMsg := &stakingtypes.MsgRedeemTokensforShares{DelegatorAddress: owner.Address, Amount: amount}
+	return k.SubmitTx(ctx, []sdk.Msg{redeemMsg}, owner, DrainMemo)
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestSetDelegationAccountCount() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	addresses := []string{
+		"cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e",
+		"cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv",
+		"cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a",
+	}
+
+	zone := icstypes.RegisteredZone{
+		ChainId:      s.chainB.ChainID,
+		ConnectionId: s.path.EndpointA.ConnectionID,
+		LocalDenom:   "uqatom",
+		BaseDenom:    "uatom",
+	}
+	for i, address := range addresses {
+		zone.DelegationAddresses = append(zone.DelegationAddresses, &icstypes.ICAAccount{
+			Address:          address,
+			PortName:         fmt.Sprintf("icacontroller-%s.delegate.%d", zone.ChainId, i),
+			DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt()),
+		})
+	}
+
+	// retired accounts can only be drained with the liquidity module, so other zones cannot retire accounts.
+	s.Require().Error(app.InterchainstakingKeeper.SetDelegationAccountCount(ctx, &zone, 2))
+	s.Require().Zero(zone.DelegationAccountCount)
+	s.Require().Len(zone.GetActiveDelegationAccounts(), 3)
+
+	// reducing the count retires the accounts beyond it.
+	zone.LiquidityModule = true
+	s.Require().NoError(app.InterchainstakingKeeper.SetDelegationAccountCount(ctx, &zone, 2))
+	s.Require().Equal(uint32(2), zone.DelegationAccountCount)
+	s.Require().Len(zone.GetActiveDelegationAccounts(), 2)
+	retired, err := zone.GetDelegationAccountByAddress(addresses[2])
+	s.Require().NoError(err)
+	s.Require().True(retired.Retired)
+
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+	bins := app.InterchainstakingKeeper.GetActiveDelegationBinsMap(ctx, &zone)
+	s.Require().Len(bins, 2)
+	s.Require().Nil(bins.Get(addresses[2]))
+	s.Require().Len(app.InterchainstakingKeeper.GetDelegationBinsMap(ctx, &zone), 3)
+
+	// increasing the count reactivates them, and registers the missing accounts.
+	s.Require().NoError(app.InterchainstakingKeeper.SetDelegationAccountCount(ctx, &zone, 4))
+	s.Require().Len(zone.GetActiveDelegationAccounts(), 3)
+
+	// accounts that are already being registered are not registered again.
+	pending := func() int {
+		count := 0
+		app.IBCKeeper.ChannelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+			if channel.State == channeltypes.INIT {
+				count++
+			}
+			return false
+		})
+		return count
+	}
+	initialised := pending()
+	s.Require().Equal(4, initialised)
+	s.Require().NoError(app.InterchainstakingKeeper.SetDelegationAccountCount(ctx, &zone, 4))
+	s.Require().Equal(initialised, pending())
+}
+
+func (s *KeeperTestSuite) TestDrainRetiredDelegationAccounts() {
+	activePort, active := s.openICAChannel(s.chainB.ChainID + ".delegate.0")
+	retiredPort, retired := s.openICAChannel(s.chainB.ChainID + ".delegate.1")
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	k := app.InterchainstakingKeeper
+
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	zone := icstypes.RegisteredZone{
+		ChainId:                s.chainB.ChainID,
+		ConnectionId:           s.path.EndpointA.ConnectionID,
+		LocalDenom:             "uqatom",
+		BaseDenom:              "uatom",
+		LiquidityModule:        true,
+		DelegationAccountCount: 1,
+		DelegationAddresses: []*icstypes.ICAAccount{
+			{Address: active, PortName: activePort, DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())},
+			{Address: retired, PortName: retiredPort, DelegatedBalance: sdk.NewCoin("uatom", sdk.NewInt(1000)), Retired: true},
+		},
+	}
+	k.SetRegisteredZone(ctx, zone)
+	k.SetDelegation(ctx, &zone, icstypes.NewDelegation(retired, validator, sdk.NewCoin("uatom", sdk.NewInt(1000))))
+
+	s.Require().NoError(k.DrainRetiredDelegationAccounts(ctx, zone))
+	operations := k.AllIcaOperations(ctx, zone.ChainId)
+	s.Require().Len(operations, 1)
+	s.Require().Equal(retiredPort, operations[0].PortId)
+	s.Require().Equal(icskeeper.DrainMemo, operations[0].Memo)
+
+	// the delegation is not tokenized again while the drain is in flight.
+	s.Require().NoError(k.DrainRetiredDelegationAccounts(ctx, zone))
+	s.Require().Len(k.AllIcaOperations(ctx, zone.ChainId), 1)
+}
@@ -20,6 +20,19 @@ func GetDelegationPlansKey(zone *types.RegisteredZone, txhash string, delAddr sd
 	return append(append(append(types.KeyPrefixDelegationPlan, []byte(zone.ChainId)...), []byte(txhash)...), delAddr.Bytes()...)
 }
 
+// delegationPlanTxhash returns the hash of the deposit that the delegation plan stored under the given key was
+// created for, or false if the key is malformed.
+func delegationPlanTxhash(zone *types.RegisteredZone, plan types.DelegationPlan, key []byte) (string, bool) {
+	prefixLength := len(types.KeyPrefixDelegationPlan) + len(zone.ChainId)
+	_, delAddr, _ := bech32.DecodeAndConvert(plan.DelegatorAddress)
+	_, valAddr, _ := bech32.DecodeAndConvert(plan.ValidatorAddress)
+	hashLength := len(key) - prefixLength - len(delAddr) - len(valAddr)
+	if hashLength <= 0 {
+		return "", false
+	}
+	return string(key[prefixLength : prefixLength+hashLength]), true
+}
+
 // GetDelegationPlan returns a specific delegation.
 func (k Keeper) GetDelegationPlan(ctx sdk.Context, zone *types.RegisteredZone, txhash string, delegatorAddress string, validatorAddress string) (delegationPlan types.DelegationPlan, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -89,6 +102,16 @@ func (k Keeper) IterateAllDelegationPlansForHash(ctx sdk.Context, zone *types.Re
 	}
 }
 
+// GetDelegationPlansForHash returns all of the delegations planned for a given transaction.
+func (k Keeper) GetDelegationPlansForHash(ctx sdk.Context, zone *types.RegisteredZone, txhash string) []types.DelegationPlan {
+	out := []types.DelegationPlan{}
+	k.IterateAllDelegationPlansForHash(ctx, zone, txhash, func(delegationPlan types.DelegationPlan) bool {
+		out = append(out, delegationPlan)
+		return false
+	})
+	return out
+}
+
 // IterateAllDelegationPlansForHashAndDelegator iterates through all of the delegations for a given transaction and delegator tuple.
 func (k Keeper) IterateAllDelegationPlansForHashAndDelegator(ctx sdk.Context, zone *types.RegisteredZone, txhash string, delegatorAddr sdk.AccAddress, cb func(delegationPlan types.DelegationPlan) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
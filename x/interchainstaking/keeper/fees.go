@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func getZoneFeesKey(chainID string) []byte {
+	return append(types.KeyPrefixFees, []byte(chainID+"/")...)
+}
+
+// AddZoneFees adds the fee to the cumulative fees collected for the zone. Fees are accounted in the denom in which
+// they were sent from the host chain (i.e. the zone's base denom), not the ibc denom in which they are received.
+func (k Keeper) AddZoneFees(ctx sdk.Context, chainID string, fee sdk.Coin) {
+	if !fee.IsPositive() {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getZoneFeesKey(chainID))
+	total := k.GetZoneFees(ctx, chainID).AmountOf(fee.Denom).Add(fee.Amount)
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(fee.Denom), bz)
+}
+
+// GetZoneFees returns the cumulative fees collected for the zone, in host chain denoms.
+func (k Keeper) GetZoneFees(ctx sdk.Context, chainID string) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getZoneFeesKey(chainID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	fees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		amount := sdk.Int{}
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		fees = fees.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return fees
+}
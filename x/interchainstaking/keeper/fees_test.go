@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestZoneFees() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone := icstypes.RegisteredZone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, LocalDenom: "uqatom", BaseDenom: "uatom"}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	app.InterchainstakingKeeper.AddZoneFees(ctx, zone.ChainId, sdk.NewCoin("uatom", sdk.NewInt(100)))
+	app.InterchainstakingKeeper.AddZoneFees(ctx, zone.ChainId, sdk.NewCoin("uatom", sdk.NewInt(50)))
+	app.InterchainstakingKeeper.AddZoneFees(ctx, zone.ChainId, sdk.NewCoin("uosmo", sdk.NewInt(10)))
+
+	res, err := app.InterchainstakingKeeper.ZoneFees(sdk.WrapSDKContext(ctx), &icstypes.QueryZoneFeesRequest{ChainId: zone.ChainId})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(150)), sdk.NewCoin("uosmo", sdk.NewInt(10))), res.Fees)
+
+	_, err = app.InterchainstakingKeeper.ZoneFees(sdk.WrapSDKContext(ctx), &icstypes.QueryZoneFeesRequest{ChainId: "unknown-1"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestHandleDistributeFeesFromModuleAccount() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone := icstypes.RegisteredZone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, LocalDenom: "uqatom", BaseDenom: "uatom"}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	treasury := sdk.AccAddress([]byte("treasury____________"))
+	params := app.InterchainstakingKeeper.GetParams(ctx)
+	params.CommunityPoolFeeShare = sdk.NewDecWithPrec(2, 1)
+	params.TreasuryFeeShare = sdk.NewDecWithPrec(3, 1)
+	params.TreasuryAddress = treasury.String()
+	app.InterchainstakingKeeper.SetParams(ctx, params)
+
+	fees := sdk.NewCoins(sdk.NewCoin("ibc/fee", sdk.NewInt(1000)))
+	escrow := sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(500)))
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees.Add(escrow...)))
+	s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, icstypes.ModuleName, fees.Add(escrow...)))
+
+	communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("ibc/fee")
+	feeCollectorBefore := app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), "ibc/fee").Amount
+
+	s.Require().NoError(app.InterchainstakingKeeper.HandleDistributeFeesFromModuleAccount(ctx))
+
+	s.Require().Equal(sdk.NewDec(200), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("ibc/fee").Sub(communityPoolBefore))
+	s.Require().Equal(sdk.NewInt(300), app.BankKeeper.GetBalance(ctx, treasury, "ibc/fee").Amount)
+	s.Require().Equal(sdk.NewInt(500), app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), "ibc/fee").Amount.Sub(feeCollectorBefore))
+	// escrowed qAssets are retained.
+	s.Require().Equal(escrow, app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(icstypes.ModuleName)))
+}
+
+func (s *KeeperTestSuite) TestGetParamsMissingKeys() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	// remove params introduced after genesis, as on a chain initialised before they existed.
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(icstypes.ModuleName), '/'))
+	for _, key := range [][]byte{icstypes.KeyCommunityPoolFeeShare, icstypes.KeyTreasuryFeeShare, icstypes.KeyTreasuryAddress, icstypes.KeyMaxMsgsPerTx} {
+		store.Delete(key)
+	}
+
+	params := app.InterchainstakingKeeper.GetParams(ctx)
+	defaults := icstypes.DefaultParams()
+	s.Require().Equal(defaults.CommunityPoolFeeShare, params.CommunityPoolFeeShare)
+	s.Require().Equal(defaults.TreasuryFeeShare, params.TreasuryFeeShare)
+	s.Require().Equal(defaults.TreasuryAddress, params.TreasuryAddress)
+	s.Require().Equal(defaults.MaxMsgsPerTx, params.MaxMsgsPerTx)
+	s.Require().NoError(app.InterchainstakingKeeper.HandleDistributeFeesFromModuleAccount(ctx))
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	k := app.InterchainstakingKeeper
+
+	delegator := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	user := "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a"
+	account := func(address string, port string) *icstypes.ICAAccount {
+		return &icstypes.ICAAccount{Address: address, PortName: port, Balance: sdk.Coins{}, DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())}
+	}
+
+	zone := icstypes.RegisteredZone{
+		ChainId:                 s.chainB.ChainID,
+		ConnectionId:            s.path.EndpointA.ConnectionID,
+		AccountPrefix:           "cosmos",
+		LocalDenom:              "uqatom",
+		BaseDenom:               "uatom",
+		RedemptionRate:          sdk.OneDec(),
+		LastRedemptionRate:      sdk.OneDec(),
+		CommissionRate:          sdk.NewDecWithPrec(2, 2),
+		MaxRedemptionRateChange: sdk.ZeroDec(),
+		MinRedemptionRate:       sdk.ZeroDec(),
+		MaxRedemptionRate:       sdk.ZeroDec(),
+		RewardsDust:             sdk.NewInt(3),
+		DepositAddress:          account("cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv", "icacontroller-"+s.chainB.ChainID+".deposit"),
+		WithdrawalAddress:       account("cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a", "icacontroller-"+s.chainB.ChainID+".withdrawal"),
+		DelegationAddresses:     []*icstypes.ICAAccount{account(delegator, "icacontroller-"+s.chainB.ChainID+".delegate.0")},
+		Validators: []*icstypes.Validator{{
+			ValoperAddress:  validator,
+			CommissionRate:  sdk.NewDecWithPrec(5, 2),
+			DelegatorShares: sdk.NewDec(1000),
+			VotingPower:     sdk.NewInt(1000),
+			Score:           sdk.ZeroDec(),
+			Status:          "BOND_STATUS_BONDED",
+		}},
+		DelegationAccountCount: 1,
+	}
+	zone.DelegationAddresses[0].DelegatedBalance = sdk.NewCoin("uatom", sdk.NewInt(1000))
+	k.SetRegisteredZone(ctx, zone)
+	for _, account := range zone.GetICAAccounts() {
+		k.SetConnectionForPort(ctx, zone.ConnectionId, account.PortName)
+	}
+
+	k.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegator, validator, sdk.NewCoin("uatom", sdk.NewInt(1000))))
+	k.SetReceipt(ctx, *k.NewReceipt(ctx, zone, user, "deposit", sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200)))))
+	k.SetDelegationPlan(ctx, &zone, "deposit", icstypes.DelegationPlan{DelegatorAddress: delegator, ValidatorAddress: validator, Value: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200)))})
+	intent := icstypes.DelegatorIntent{Delegator: user, Intents: []*icstypes.ValidatorIntent{{ValoperAddress: validator, Weight: sdk.OneDec()}}}
+	k.SetIntent(ctx, zone, intent, false)
+	k.SetIntent(ctx, zone, intent, true)
+	k.SetWithdrawalRecord(ctx, &icstypes.WithdrawalRecord{
+		Delegator:      delegator,
+		Validator:      validator,
+		Recipient:      user,
+		Amount:         sdk.NewCoin("uatom", sdk.NewInt(100)),
+		BurnAmount:     sdk.NewCoin("uqatom", sdk.NewInt(100)),
+		Txhash:         "redemption",
+		Status:         icstypes.WithdrawStatusUnbond,
+		CompletionTime: ctx.BlockTime().Add(time.Hour).UTC(),
+	})
+	k.SetRefund(ctx, icstypes.Refund{ChainId: zone.ChainId, Txhash: "refund", Recipient: user, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(5))), Reason: "test"})
+	k.SetIBCDeposit(ctx, icstypes.IBCDeposit{ChainId: zone.ChainId, Receiver: user, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(7))), ChannelId: "channel-0", Sequence: 1})
+	k.SetSlashingIncident(ctx, icstypes.SlashingIncident{ChainId: zone.ChainId, Validator: validator, Height: 10, Time: ctx.BlockTime().UTC(), Fraction: sdk.NewDecWithPrec(1, 2), TokensLost: sdk.NewInt(10)})
+	k.SetIcaOperation(ctx, icstypes.IcaOperation{ChainId: zone.ChainId, PortId: zone.DepositAddress.PortName, ChannelId: "channel-1", Sequence: 2, Data: []byte("data"), Attempts: 1, MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"}})
+	k.AddZoneFees(ctx, zone.ChainId, sdk.NewCoin("uqatom", sdk.NewInt(11)))
+	k.SetRedemptionEscrow(ctx, icstypes.RedemptionEscrow{ChainId: zone.ChainId, Txhash: "redemption", Redeemer: user, Amount: sdk.NewCoin("uqatom", sdk.NewInt(100)), Refunded: sdk.NewCoin("uqatom", sdk.ZeroInt())})
+
+	k.SetValsetPassValidator(ctx, zone.ChainId, "BOND_STATUS_BONDED", validator)
+
+	exported := interchainstaking.ExportGenesis(ctx, k)
+	s.Require().NoError(exported.Validate())
+	s.Require().Equal([]icstypes.ValsetPass{{ChainId: zone.ChainId, Status: "BOND_STATUS_BONDED", Validators: []string{validator}}}, exported.ValsetPasses)
+	s.Require().Len(exported.WithdrawalRecords, 1)
+	s.Require().Len(exported.Refunds, 1)
+	s.Require().Len(exported.IbcDeposits, 1)
+	s.Require().Len(exported.SlashingIncidents, 1)
+	s.Require().Len(exported.IcaOperations, 1)
+	s.Require().Len(exported.ZoneFees, 1)
+	s.Require().Len(exported.RedemptionEscrows, 1)
+
+	// receipts and withdrawal records must refer to a zone in the genesis state.
+	invalid := *exported
+	invalid.Receipts = []icstypes.Receipt{{Zone: &icstypes.RegisteredZone{ChainId: "unknown-1"}, Txhash: "deposit"}}
+	s.Require().Error(invalid.Validate())
+	invalid.Receipts = []icstypes.Receipt{{Txhash: "deposit"}}
+	s.Require().Error(invalid.Validate())
+	invalid = *exported
+	invalid.WithdrawalRecords = []icstypes.WithdrawalRecord{{Delegator: user, Txhash: "redemption"}}
+	s.Require().Error(invalid.Validate())
+
+	// import into a chain without the zone, whose interchain account ports are unbound.
+	appB := s.GetQuicksilverApp(s.chainB)
+	ctxB := s.chainB.GetContext()
+	for _, account := range zone.GetICAAccounts() {
+		s.Require().False(appB.ICAControllerKeeper.IsBound(ctxB, account.PortName))
+	}
+
+	interchainstaking.InitGenesis(ctxB, appB.InterchainstakingKeeper, *exported)
+
+	reexported := interchainstaking.ExportGenesis(ctxB, appB.InterchainstakingKeeper)
+	s.Require().True(appB.InterchainstakingKeeper.IsValsetPassValidator(ctxB, zone.ChainId, "BOND_STATUS_BONDED", validator))
+	s.Require().Equal(string(app.AppCodec().MustMarshalJSON(exported)), string(appB.AppCodec().MustMarshalJSON(reexported)))
+	s.Require().Len(appB.InterchainstakingKeeper.AllIntents(ctxB, zone, true), 1)
+
+	for _, account := range zone.GetICAAccounts() {
+		s.Require().True(appB.ICAControllerKeeper.IsBound(ctxB, account.PortName))
+		address, found := appB.ICAControllerKeeper.GetInterchainAccountAddress(ctxB, zone.ConnectionId, account.PortName)
+		s.Require().True(found)
+		s.Require().Equal(account.Address, address)
+		connectionID, err := appB.InterchainstakingKeeper.GetConnectionForPort(ctxB, account.PortName)
+		s.Require().NoError(err)
+		s.Require().Equal(zone.ConnectionId, connectionID)
+	}
+}
@@ -157,3 +157,242 @@ func (k Keeper) DelegationPlans(c context.Context, req *types.QueryDelegationPla
 
 	return &types.QueryDelegationPlansResponse{Delegations: delegationplans}, nil
 }
+
+// ZoneFees returns the cumulative fees collected for the given zone.
+func (k Keeper) ZoneFees(c context.Context, req *types.QueryZoneFeesRequest) (*types.QueryZoneFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetRegisteredZoneInfo(ctx, req.GetChainId()); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	return &types.QueryZoneFeesResponse{
+		Fees: k.GetZoneFees(ctx, req.GetChainId()),
+	}, nil
+}
+
+// Refunds returns the refunds of rejected deposits for the given zone.
+func (k Keeper) Refunds(c context.Context, req *types.QueryRefundsRequest) (*types.QueryRefundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetRegisteredZoneInfo(ctx, req.GetChainId()); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	var refunds []types.Refund
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getRefundsKey(req.GetChainId()))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var refund types.Refund
+		if err := k.cdc.Unmarshal(value, &refund); err != nil {
+			return false, err
+		}
+		if req.GetRecipient() != "" && refund.Recipient != req.GetRecipient() {
+			return false, nil
+		}
+		if accumulate {
+			refunds = append(refunds, refund)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRefundsResponse{
+		Refunds:    refunds,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Receipts returns the deposit receipts for the given zone.
+func (k Keeper) Receipts(c context.Context, req *types.QueryReceiptsRequest) (*types.QueryReceiptsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetRegisteredZoneInfo(ctx, req.GetChainId()); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	var receipts []types.Receipt
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixReceipt, []byte(req.GetChainId()+"/")...))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var receipt types.Receipt
+		if err := k.cdc.Unmarshal(value, &receipt); err != nil {
+			return false, err
+		}
+		if req.GetSender() != "" && receipt.Sender != req.GetSender() {
+			return false, nil
+		}
+		if accumulate {
+			receipts = append(receipts, receipt)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReceiptsResponse{
+		Receipts:   receipts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Receipt returns the deposit receipt of the given transaction for the given zone.
+func (k Keeper) Receipt(c context.Context, req *types.QueryReceiptRequest) (*types.QueryReceiptResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetRegisteredZoneInfo(ctx, req.GetChainId())
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	receipt, found := k.GetReceipt(ctx, GetReceiptKey(zone, req.GetTxhash()))
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no receipt found for %s", req.GetTxhash()))
+	}
+
+	return &types.QueryReceiptResponse{Receipt: &receipt}, nil
+}
+
+// SlashingIncidents returns the detected slashes of validators for the given zone.
+func (k Keeper) SlashingIncidents(c context.Context, req *types.QuerySlashingIncidentsRequest) (*types.QuerySlashingIncidentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetRegisteredZoneInfo(ctx, req.GetChainId()); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	var incidents []types.SlashingIncident
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getSlashingIncidentsKey(req.GetChainId()))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var incident types.SlashingIncident
+		if err := k.cdc.Unmarshal(value, &incident); err != nil {
+			return false, err
+		}
+		if req.GetValidator() != "" && incident.Validator != req.GetValidator() {
+			return false, nil
+		}
+		if accumulate {
+			incidents = append(incidents, incident)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashingIncidentsResponse{
+		Incidents:  incidents,
+		Pagination: pageRes,
+	}, nil
+}
+
+// IcaOperations returns the unresolved interchain account operations of the given zone, optionally filtered by status.
+func (k Keeper) IcaOperations(c context.Context, req *types.QueryIcaOperationsRequest) (*types.QueryIcaOperationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetRegisteredZoneInfo(ctx, req.GetChainId()); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	var operations []types.IcaOperation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getIcaOperationsKey(req.GetChainId()))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var operation types.IcaOperation
+		if err := k.cdc.Unmarshal(value, &operation); err != nil {
+			return false, err
+		}
+		if len(req.GetStatuses()) > 0 && !hasIcaOperationStatus(operation, req.GetStatuses()) {
+			return false, nil
+		}
+		if accumulate {
+			operations = append(operations, operation)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIcaOperationsResponse{
+		Operations: operations,
+		Pagination: pageRes,
+	}, nil
+}
+
+// WithdrawalRecords returns the withdrawal records of redemptions from the given zone.
+func (k Keeper) WithdrawalRecords(c context.Context, req *types.QueryWithdrawalRecordsRequest) (*types.QueryWithdrawalRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	zone, found := k.GetRegisteredZoneInfo(ctx, req.GetChainId())
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	if req.GetDelegator() != "" && !zone.IsDelegateAddress(req.GetDelegator()) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s is not a delegation account of %s", req.GetDelegator(), req.GetChainId()))
+	}
+
+	var records []types.WithdrawalRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixWithdrawalRecord, []byte(req.GetDelegator())...))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var record types.WithdrawalRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+		if !zone.IsDelegateAddress(record.Delegator) {
+			return false, nil
+		}
+		if req.GetRecipient() != "" && record.Recipient != req.GetRecipient() {
+			return false, nil
+		}
+		if req.GetStatus() != types.WithdrawStatusUnspecified && record.Status != req.GetStatus() {
+			return false, nil
+		}
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWithdrawalRecordsResponse{
+		Withdrawals: records,
+		Pagination:  pageRes,
+	}, nil
+}
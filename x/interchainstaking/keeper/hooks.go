@@ -16,54 +16,111 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	// every epoch
 	k.Logger(ctx).Info("handling epoch end")
 	if epochIdentifier == "epoch" {
+		sunset := []string{}
 		k.IterateRegisteredZones(ctx, func(index int64, zoneInfo types.RegisteredZone) (stop bool) {
-			k.Logger(ctx).Info("taking a snapshot of intents")
-			k.AggregateIntents(ctx, zoneInfo)
-			if zoneInfo.WithdrawalWaitgroup > 0 {
-				k.Logger(ctx).Error("epoch waitgroup was unexpected > 0; this means we did not process the previous epoch!")
-				zoneInfo.WithdrawalWaitgroup = 0
+			if k.IsSunsetComplete(ctx, zoneInfo) {
+				sunset = append(sunset, zoneInfo.ChainId)
+				return false
+			}
+			k.PruneIcaOperations(ctx, zoneInfo.ChainId)
+			if zoneInfo.IsPaused() {
+				k.Logger(ctx).Info("zone is paused; skipping epoch processing", "zone", zoneInfo.ChainId)
+				return false
 			}
-			// OnChanOpenAck calls SetWithdrawalAddress (see ibc_module.go)
-			for _, da := range zoneInfo.GetDelegationAccounts() {
-				k.Logger(ctx).Info("Withdrawing rewards")
-
-				delegationQuery := stakingtypes.QueryDelegatorDelegationsRequest{DelegatorAddr: da.Address}
-				bz := k.cdc.MustMarshal(&delegationQuery)
-
-				k.ICQKeeper.MakeRequest(
-					ctx,
-					zoneInfo.ConnectionId,
-					zoneInfo.ChainId,
-					"cosmos.staking.v1beta1.Query/DelegatorDelegations",
-					bz,
-					sdk.NewInt(-1),
-					types.ModuleName,
-					"delegations",
-					0,
-				)
-
-				rewardsQuery := distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: da.Address}
-				bz = k.cdc.MustMarshal(&rewardsQuery)
-
-				k.ICQKeeper.MakeRequest(
-					ctx,
-					zoneInfo.ConnectionId,
-					zoneInfo.ChainId,
-					"cosmos.distribution.v1beta1.Query/DelegationTotalRewards",
-					bz,
-					sdk.NewInt(-1),
-					types.ModuleName,
-					"rewards",
-					0,
-				)
-
-				zoneInfo.WithdrawalWaitgroup++
-				k.Logger(ctx).Info("Incrementing waitgroup for delegation", "value", zoneInfo.WithdrawalWaitgroup)
+
+			k.Logger(ctx).Info("taking a snapshot of intents")
+			k.AggregateIntents(ctx, &zoneInfo)
+			if !zoneInfo.IsAvailable() {
+				k.Logger(ctx).Error("zone has unavailable interchain accounts; deferring epoch processing", "zone", zoneInfo.ChainId)
+				zoneInfo.DeferredEpoch = epochNumber
+				k.SetRegisteredZone(ctx, zoneInfo)
+				return false
 			}
-			k.SetRegisteredZone(ctx, zoneInfo)
+			k.HandleEpochForZone(ctx, zoneInfo, epochNumber)
 
 			return false
 		})
+
+		// zones are removed after iteration, as deleting mid-iteration breaks the iterator.
+		for _, chainID := range sunset {
+			k.Logger(ctx).Info("zone sunset complete; removing zone", "zone", chainID)
+			k.DeleteRegisteredZone(ctx, chainID)
+		}
+	}
+}
+
+// HandleEpochForZone withdraws rewards from the zone's delegation accounts, and processes queued withdrawals.
+func (k Keeper) HandleEpochForZone(ctx sdk.Context, zoneInfo types.RegisteredZone, epochNumber int64) {
+	zoneInfo.DeferredEpoch = 0
+	if zoneInfo.WithdrawalWaitgroup > 0 {
+		k.Logger(ctx).Error("epoch waitgroup was unexpected > 0; this means we did not process the previous epoch!")
+		zoneInfo.WithdrawalWaitgroup = 0
+	}
+	// OnChanOpenAck calls SetWithdrawalAddress (see ibc_module.go)
+	for _, da := range zoneInfo.GetDelegationAccounts() {
+		k.Logger(ctx).Info("Withdrawing rewards")
+
+		delegationQuery := stakingtypes.QueryDelegatorDelegationsRequest{DelegatorAddr: da.Address}
+		bz := k.cdc.MustMarshal(&delegationQuery)
+
+		k.ICQKeeper.MakeRequest(
+			ctx,
+			zoneInfo.ConnectionId,
+			zoneInfo.ChainId,
+			"cosmos.staking.v1beta1.Query/DelegatorDelegations",
+			bz,
+			sdk.NewInt(-1),
+			types.ModuleName,
+			"delegations",
+			0,
+		)
+
+		// rewards of a sunsetting zone would be delegated again, so are left unclaimed.
+		if zoneInfo.Sunsetting {
+			continue
+		}
+
+		rewardsQuery := distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: da.Address}
+		bz = k.cdc.MustMarshal(&rewardsQuery)
+
+		k.ICQKeeper.MakeRequest(
+			ctx,
+			zoneInfo.ConnectionId,
+			zoneInfo.ChainId,
+			"cosmos.distribution.v1beta1.Query/DelegationTotalRewards",
+			bz,
+			sdk.NewInt(-1),
+			types.ModuleName,
+			"rewards",
+			0,
+		)
+
+		zoneInfo.WithdrawalWaitgroup++
+		k.Logger(ctx).Info("Incrementing waitgroup for delegation", "value", zoneInfo.WithdrawalWaitgroup)
+	}
+	k.SetRegisteredZone(ctx, zoneInfo)
+
+	if zoneInfo.RedemptionsEnabled() {
+		// redemptions from zones with the liquidity module are not queued, but their withdrawals may be: those queued
+		// before the liquidity module was enabled. Queued withdrawals of a sunsetting zone are covered by the sunset
+		// unbonding, which HandleSunsetEpoch re-issues.
+		if !zoneInfo.Sunsetting {
+			if err := k.HandleQueuedUnbondings(ctx, &zoneInfo, epochNumber); err != nil {
+				k.Logger(ctx).Error("error handling queued unbondings", "zone", zoneInfo.ChainId, "err", err)
+			}
+		}
+		if err := k.PayoutUnbondedWithdrawals(ctx, &zoneInfo); err != nil {
+			k.Logger(ctx).Error("error paying out unbonded withdrawals", "zone", zoneInfo.ChainId, "err", err)
+		}
+	}
+	if zoneInfo.Sunsetting {
+		k.HandleSunsetEpoch(ctx, zoneInfo)
+	}
+	if err := k.Rebalance(ctx, zoneInfo); err != nil {
+		k.Logger(ctx).Error("error rebalancing delegations", "zone", zoneInfo.ChainId, "err", err)
+	}
+	if err := k.DrainRetiredDelegationAccounts(ctx, zoneInfo); err != nil {
+		k.Logger(ctx).Error("error draining retired delegation accounts", "zone", zoneInfo.ChainId, "err", err)
 	}
 }
 
@@ -0,0 +1,330 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// IBCDepositTimeout is the timeout of transfers forwarding IBC deposits to the zone deposit account.
+const IBCDepositTimeout = 10 * time.Minute
+
+func getIBCDepositKey(channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%d", channelID, sequence))
+}
+
+// GetIBCDepositHash returns the receipt hash of the IBC deposit forwarded by the given packet.
+func GetIBCDepositHash(channelID string, sequence uint64) string {
+	return fmt.Sprintf("ibc/%s/%d", channelID, sequence)
+}
+
+// GetIBCDeposit returns the IBC deposit forwarded by the given packet.
+func (k Keeper) GetIBCDeposit(ctx sdk.Context, channelID string, sequence uint64) (types.IBCDeposit, bool) {
+	deposit := types.IBCDeposit{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIBCDeposit)
+	bz := store.Get(getIBCDepositKey(channelID, sequence))
+	if len(bz) == 0 {
+		return deposit, false
+	}
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// SetIBCDeposit stores the IBC deposit.
+func (k Keeper) SetIBCDeposit(ctx sdk.Context, deposit types.IBCDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIBCDeposit)
+	store.Set(getIBCDepositKey(deposit.ChannelId, deposit.Sequence), k.cdc.MustMarshal(&deposit))
+}
+
+// DeleteIBCDeposit deletes the IBC deposit forwarded by the given packet.
+func (k Keeper) DeleteIBCDeposit(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIBCDeposit)
+	store.Delete(getIBCDepositKey(channelID, sequence))
+}
+
+// IterateIBCDeposits iterates through the IBC deposits of all zones.
+func (k Keeper) IterateIBCDeposits(ctx sdk.Context, fn func(index int64, deposit types.IBCDeposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIBCDeposit)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		deposit := types.IBCDeposit{}
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		if fn(i, deposit) {
+			break
+		}
+		i++
+	}
+}
+
+// GetZoneForTransferChannel returns the zone whose connection the given channel is opened on.
+func (k Keeper) GetZoneForTransferChannel(ctx sdk.Context, portID string, channelID string) (types.RegisteredZone, bool) {
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found || len(channel.ConnectionHops) == 0 {
+		return types.RegisteredZone{}, false
+	}
+
+	var zone types.RegisteredZone
+	found = false
+	k.IterateRegisteredZones(ctx, func(_ int64, zoneInfo types.RegisteredZone) bool {
+		if zoneInfo.ConnectionId == channel.ConnectionHops[0] {
+			zone = zoneInfo
+			found = true
+			return true
+		}
+		return false
+	})
+	return zone, found
+}
+
+// HandleIBCDeposit forwards a deposit received by IBC transfer from the receiver to the zone deposit account. qAssets
+// are minted to the receiver once the forwarding transfer is acknowledged.
+func (k Keeper) HandleIBCDeposit(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, intent string) error {
+	zone, found := k.GetZoneForTransferChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return fmt.Errorf("no zone found for channel %s", packet.DestinationChannel)
+	}
+
+	if data.Denom != zone.BaseDenom {
+		return fmt.Errorf("unexpected denom %s for zone %s; expected %s", data.Denom, zone.ChainId, zone.BaseDenom)
+	}
+
+	if zone.Sunsetting || !zone.DepositsEnabled() || zone.CircuitBreakerTripped {
+		return fmt.Errorf("zone %s is not accepting deposits", zone.ChainId)
+	}
+
+	if zone.DepositAddress == nil {
+		return fmt.Errorf("no deposit account registered for zone %s", zone.ChainId)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return fmt.Errorf("invalid receiver %s: %w", data.Receiver, err)
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok || !amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s", data.Amount)
+	}
+
+	depositMemo, err := zone.ParseMemo(intent)
+	if err != nil {
+		return fmt.Errorf("invalid intent memo: %w", err)
+	}
+	if len(depositMemo.Recipient) > 0 {
+		return fmt.Errorf("intent memo recipient is not supported for IBC deposits; qAssets are minted to the transfer receiver")
+	}
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, data.Denom)).IBCDenom()
+	voucher := sdk.NewCoin(voucherDenom, amount)
+
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, receiver, types.ModuleName, sdk.NewCoins(voucher)); err != nil {
+		return err
+	}
+
+	sequence, found := k.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return fmt.Errorf("no send sequence found for channel %s", packet.DestinationChannel)
+	}
+
+	timeout := uint64(ctx.BlockTime().Add(IBCDepositTimeout).UnixNano())
+	if err := k.TransferKeeper.SendTransfer(ctx, packet.DestinationPort, packet.DestinationChannel, voucher, k.AccountKeeper.GetModuleAddress(types.ModuleName), zone.DepositAddress.Address, clienttypes.ZeroHeight(), timeout); err != nil {
+		return err
+	}
+
+	k.SetIBCDeposit(ctx, types.IBCDeposit{
+		ChainId:   zone.ChainId,
+		Receiver:  data.Receiver,
+		Amount:    sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, amount)),
+		Intent:    intent,
+		ChannelId: packet.DestinationChannel,
+		Sequence:  sequence,
+	})
+
+	k.Logger(ctx).Info("forwarded IBC deposit to deposit account", "zone", zone.ChainId, "receiver", data.Receiver, "amount", voucher, "sequence", sequence)
+	return nil
+}
+
+// HandleIBCDepositAcknowledgement handles the acknowledgement of a transfer forwarding an IBC deposit. On success qAssets
+// are minted to the receiver, otherwise the refunded vouchers are returned to the receiver.
+func (k Keeper) HandleIBCDepositAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, success bool) error {
+	deposit, found := k.GetIBCDeposit(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		// not a forwarded deposit.
+		return nil
+	}
+
+	if !success {
+		return k.refundIBCDeposit(ctx, packet, deposit)
+	}
+
+	deposit.Acknowledged = true
+	k.SetIBCDeposit(ctx, deposit)
+
+	zone, found := k.GetRegisteredZoneInfo(ctx, deposit.ChainId)
+	if !found {
+		k.Logger(ctx).Error("no registered zone for IBC deposit", "zone", deposit.ChainId, "receiver", deposit.Receiver)
+		return nil
+	}
+
+	k.completeIBCDeposit(ctx, zone, deposit)
+	return nil
+}
+
+// completeIBCDeposit mints qAssets for an acknowledged IBC deposit and delegates the deposited funds. If minting fails,
+// the deposit is retained to be completed when the zone is resumed.
+func (k Keeper) completeIBCDeposit(ctx sdk.Context, zone types.RegisteredZone, deposit types.IBCDeposit) {
+	receiver, err := sdk.AccAddressFromBech32(deposit.Receiver)
+	if err != nil {
+		// validated on receipt.
+		panic(err)
+	}
+	hash := GetIBCDepositHash(deposit.ChannelId, deposit.Sequence)
+
+	// NOTE: intents are updated before minting, so existing intent is weighted by the balance prior to the deposit;
+	// use a cached context, so the intent of a retained deposit is not updated until its qAssets are minted.
+	cacheCtx, write := ctx.CacheContext()
+	depositMemo := k.parseDepositMemo(cacheCtx, zone, deposit.Intent, deposit.Receiver, hash)
+	k.UpdateIntent(cacheCtx, receiver, zone, deposit.Amount, depositMemo.ToOrdinalIntents(deposit.Amount, zone.BaseDenom))
+	if err := k.MintQAsset(cacheCtx, receiver, zone, deposit.Amount); err != nil {
+		k.Logger(ctx).Error("unable to mint qAsset for IBC deposit; retaining deposit", "receiver", deposit.Receiver, "zone", zone.ChainId, "err", err)
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	k.DeleteIBCDeposit(ctx, deposit.ChannelId, deposit.Sequence)
+	receipt := k.NewReceipt(ctx, zone, deposit.Receiver, hash, deposit.Amount)
+	k.SetReceipt(ctx, *receipt)
+
+	sendPlan, err := k.DeterminePlanForDelegation(ctx, zone, deposit.Amount, deposit.Receiver, hash)
+	if err != nil {
+		k.Logger(ctx).Error("unable to determine delegation plan. Ignoring.", "receiver", deposit.Receiver, "zone", zone.ChainId, "err", err)
+		return
+	}
+	receipt.DelegationPlans = k.GetDelegationPlansForHash(ctx, &zone, hash)
+	k.SetReceipt(ctx, *receipt)
+
+	if err := k.TransferToDelegate(ctx, zone, sendPlan, hash); err != nil {
+		k.Logger(ctx).Error("unable to transfer to delegate. Ignoring.", "receiver", deposit.Receiver, "zone", zone.ChainId, "err", err)
+	}
+}
+
+// refundIBCDeposit returns the vouchers of a failed forwarding transfer, refunded to the module account by the transfer
+// module, to the receiver.
+func (k Keeper) refundIBCDeposit(ctx sdk.Context, packet channeltypes.Packet, deposit types.IBCDeposit) error {
+	k.DeleteIBCDeposit(ctx, deposit.ChannelId, deposit.Sequence)
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return err
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return fmt.Errorf("invalid amount %s", data.Amount)
+	}
+	voucher := sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount)
+
+	receiver, err := sdk.AccAddressFromBech32(deposit.Receiver)
+	if err != nil {
+		return err
+	}
+
+	if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(voucher)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeIBCDepositRefund,
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, deposit.ChainId),
+			sdk.NewAttribute(types.AttributeKeyRecipientAddress, deposit.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, voucher.String()),
+		),
+	})
+
+	k.Logger(ctx).Info("refunded IBC deposit", "zone", deposit.ChainId, "receiver", deposit.Receiver, "amount", voucher)
+	return nil
+}
+
+// completeAcknowledgedIBCDeposits completes IBC deposits of the zone that were acknowledged while minting was paused.
+func (k Keeper) completeAcknowledgedIBCDeposits(ctx sdk.Context, zone types.RegisteredZone) {
+	deposits := []types.IBCDeposit{}
+	k.IterateIBCDeposits(ctx, func(_ int64, deposit types.IBCDeposit) bool {
+		if deposit.ChainId == zone.ChainId && deposit.Acknowledged {
+			deposits = append(deposits, deposit)
+		}
+		return false
+	})
+
+	for _, deposit := range deposits {
+		k.completeIBCDeposit(ctx, zone, deposit)
+	}
+}
+
+// isForwardedIBCDeposit returns true if the host chain transaction received a transfer forwarding an IBC deposit,
+// for which qAssets are minted on acknowledgement.
+func (k Keeper) isForwardedIBCDeposit(ctx sdk.Context, zone types.RegisteredZone, txn *tx.Tx) bool {
+	if txn.Body == nil {
+		return false
+	}
+
+	for _, msg := range txn.Body.Messages {
+		if msg.TypeUrl != "/ibc.core.channel.v1.MsgRecvPacket" {
+			continue
+		}
+		recvMsg := channeltypes.MsgRecvPacket{}
+		if err := k.cdc.Unmarshal(msg.Value, &recvMsg); err != nil {
+			continue
+		}
+		packet := recvMsg.Packet
+		if packet.SourcePort != transfertypes.PortID {
+			continue
+		}
+		if _, found := k.GetIBCDeposit(ctx, packet.SourceChannel, packet.Sequence); found {
+			return true
+		}
+		if _, found := k.GetReceipt(ctx, GetReceiptKey(zone, GetIBCDepositHash(packet.SourceChannel, packet.Sequence))); found {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseIBCDepositMemo returns the memo of the transfer packet data, and the packet data without the memo, as the ICS-20
+// packet data does not support memos.
+func ParseIBCDepositMemo(bz []byte) (transfertypes.FungibleTokenPacketData, *types.IBCDepositMemo, error) {
+	raw := struct {
+		transfertypes.FungibleTokenPacketData
+		Memo string `json:"memo,omitempty"`
+	}{}
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return transfertypes.FungibleTokenPacketData{}, nil, err
+	}
+
+	if raw.Memo == "" {
+		return raw.FungibleTokenPacketData, nil, nil
+	}
+
+	memo := types.IBCDepositMemo{}
+	if err := json.Unmarshal([]byte(raw.Memo), &memo); err != nil || memo.LiquidStake == nil {
+		// not a deposit memo.
+		return raw.FungibleTokenPacketData, nil, nil
+	}
+	return raw.FungibleTokenPacketData, &memo, nil
+}
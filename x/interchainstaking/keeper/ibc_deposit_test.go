@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestParseIBCDepositMemo() {
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", "quick1receiver")
+
+	parsed, memo, err := icskeeper.ParseIBCDepositMemo(data.GetBytes())
+	s.Require().NoError(err)
+	s.Require().Nil(memo)
+	s.Require().Equal(data, parsed)
+
+	bz := []byte(`{"amount":"1000","denom":"uatom","memo":"{\"liquid_stake\":{\"intent\":\"AQID\"}}","receiver":"quick1receiver","sender":"cosmos1sender"}`)
+	parsed, memo, err = icskeeper.ParseIBCDepositMemo(bz)
+	s.Require().NoError(err)
+	s.Require().NotNil(memo)
+	s.Require().Equal("AQID", memo.LiquidStake.Intent)
+	s.Require().Equal(data, parsed)
+
+	bz = []byte(`{"amount":"1000","denom":"uatom","memo":"hello","receiver":"quick1receiver","sender":"cosmos1sender"}`)
+	_, memo, err = icskeeper.ParseIBCDepositMemo(bz)
+	s.Require().NoError(err)
+	s.Require().Nil(memo)
+}
+
+func (s *KeeperTestSuite) TestHandleIBCDepositAcknowledgement() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone := icstypes.RegisteredZone{
+		ChainId:        s.chainB.ChainID,
+		ConnectionId:   s.path.EndpointA.ConnectionID,
+		LocalDenom:     "uqatom",
+		BaseDenom:      "uatom",
+		RedemptionRate: sdk.OneDec(),
+		Validators: []*icstypes.Validator{
+			{ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", CommissionRate: sdk.NewDecWithPrec(1, 1), DelegatorShares: sdk.OneDec(), VotingPower: sdk.OneInt(), Score: sdk.ZeroDec()},
+		},
+		DepositAddress: &icstypes.ICAAccount{Address: "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv", PortName: "icacontroller-deposit"},
+		DelegationAddresses: []*icstypes.ICAAccount{
+			{Address: "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e", DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt()), PortName: "icacontroller-delegate.0"},
+		},
+	}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	receiver := s.chainA.SenderAccount.GetAddress()
+	amount := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)))
+
+	// successful forwarding mints qAssets to the receiver.
+	app.InterchainstakingKeeper.SetIBCDeposit(ctx, icstypes.IBCDeposit{ChainId: zone.ChainId, Receiver: receiver.String(), Amount: amount, ChannelId: "channel-0", Sequence: 1})
+	packet := channeltypes.Packet{SourcePort: transfertypes.PortID, SourceChannel: "channel-0", Sequence: 1}
+	s.Require().NoError(app.InterchainstakingKeeper.HandleIBCDepositAcknowledgement(ctx, packet, true))
+
+	s.Require().Equal(sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, receiver, "uqatom").Amount)
+	_, found := app.InterchainstakingKeeper.GetIBCDeposit(ctx, "channel-0", 1)
+	s.Require().False(found)
+	_, found = app.InterchainstakingKeeper.GetReceipt(ctx, icskeeper.GetReceiptKey(zone, icskeeper.GetIBCDepositHash("channel-0", 1)))
+	s.Require().True(found)
+
+	// failed forwarding returns the refunded vouchers to the receiver.
+	denom := transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-0", "uatom")
+	voucher := sdk.NewCoin(transfertypes.ParseDenomTrace(denom).IBCDenom(), sdk.NewInt(1000))
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(voucher)))
+
+	app.InterchainstakingKeeper.SetIBCDeposit(ctx, icstypes.IBCDeposit{ChainId: zone.ChainId, Receiver: receiver.String(), Amount: amount, ChannelId: "channel-0", Sequence: 2})
+	data := transfertypes.NewFungibleTokenPacketData(denom, "1000", app.AccountKeeper.GetModuleAddress(icstypes.ModuleName).String(), "cosmos1deposit")
+	packet = channeltypes.Packet{SourcePort: transfertypes.PortID, SourceChannel: "channel-0", Sequence: 2, Data: data.GetBytes()}
+	s.Require().NoError(app.InterchainstakingKeeper.HandleIBCDepositAcknowledgement(ctx, packet, false))
+
+	s.Require().Equal(voucher, app.BankKeeper.GetBalance(ctx, receiver, voucher.Denom))
+	s.Require().Equal(sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, receiver, "uqatom").Amount)
+	_, found = app.InterchainstakingKeeper.GetIBCDeposit(ctx, "channel-0", 2)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestRetainedIBCDepositIntent() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	k := app.InterchainstakingKeeper
+
+	zone := icstypes.RegisteredZone{
+		ChainId:            s.chainB.ChainID,
+		ConnectionId:       s.path.EndpointA.ConnectionID,
+		AccountPrefix:      "cosmos",
+		LocalDenom:         "uqatom",
+		BaseDenom:          "uatom",
+		RedemptionRate:     sdk.OneDec(),
+		LastRedemptionRate: sdk.OneDec(),
+		Validators: []*icstypes.Validator{
+			{ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", CommissionRate: sdk.NewDecWithPrec(1, 1), DelegatorShares: sdk.OneDec(), VotingPower: sdk.OneInt(), Score: sdk.ZeroDec()},
+			{ValoperAddress: "cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf", CommissionRate: sdk.NewDecWithPrec(1, 1), DelegatorShares: sdk.OneDec(), VotingPower: sdk.OneInt(), Score: sdk.ZeroDec()},
+		},
+		DepositAddress: &icstypes.ICAAccount{Address: "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv", PortName: "icacontroller-deposit"},
+		DelegationAddresses: []*icstypes.ICAAccount{
+			{Address: "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e", DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt()), PortName: "icacontroller-delegate.0"},
+		},
+	}
+	k.SetRegisteredZone(ctx, zone)
+	k.TripCircuitBreaker(ctx, zone, sdk.NewDecWithPrec(5, 1), fmt.Errorf("rate dropped"))
+
+	receiver := sdk.AccAddress([]byte("receiver____________"))
+	amount := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)))
+	intent := "WoS/+Ex92tEcuMBzhukZKMVnXKS8bqaQBJTx9zza4rrxyLiP9fwLijOc"
+
+	// the receiver holds qAssets, with an intent for a single validator.
+	held := sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(1000)))
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, held))
+	s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, icstypes.ModuleName, receiver, held))
+	existing := icstypes.DelegatorIntent{Delegator: receiver.String(), Intents: []*icstypes.ValidatorIntent{{ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", Weight: sdk.OneDec()}}}
+	k.SetIntent(ctx, zone, existing, false)
+
+	// a deposit acknowledged while the zone is paused is retained, without updating the receiver's intent, however many
+	// times it is retried.
+	k.SetIBCDeposit(ctx, icstypes.IBCDeposit{ChainId: zone.ChainId, Receiver: receiver.String(), Amount: amount, Intent: intent, ChannelId: "channel-0", Sequence: 1})
+	packet := channeltypes.Packet{SourcePort: transfertypes.PortID, SourceChannel: "channel-0", Sequence: 1}
+	s.Require().NoError(k.HandleIBCDepositAcknowledgement(ctx, packet, true))
+	s.Require().NoError(k.HandleIBCDepositAcknowledgement(ctx, packet, true))
+
+	_, found := k.GetIBCDeposit(ctx, "channel-0", 1)
+	s.Require().True(found)
+	delegatorIntent, _ := k.GetIntent(ctx, zone, receiver.String(), false)
+	s.Require().Equal(existing, delegatorIntent)
+	s.Require().Equal(held, app.BankKeeper.GetAllBalances(ctx, receiver))
+
+	// on resumption the deposit is minted, and its intent applied once, weighted by the balance prior to the deposit.
+	s.Require().NoError(icskeeper.HandleResumeZoneProposal(ctx, k, icstypes.NewResumeZoneProposal("resume", "resume zone", zone.ChainId)))
+
+	_, found = k.GetIBCDeposit(ctx, "channel-0", 1)
+	s.Require().False(found)
+	s.Require().Equal(sdk.NewInt(2000), app.BankKeeper.GetBalance(ctx, receiver, "uqatom").Amount)
+	delegatorIntent, _ = k.GetIntent(ctx, zone, receiver.String(), false)
+	weights := map[string]sdk.Dec{}
+	for _, v := range delegatorIntent.Intents {
+		weights[v.ValoperAddress] = v.Weight
+	}
+	s.Require().Equal(map[string]sdk.Dec{
+		"cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0": sdk.NewDecWithPrec(725, 3),
+		"cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf": sdk.NewDecWithPrec(275, 3),
+	}, weights)
+}
@@ -28,8 +28,20 @@ import (
 )
 
 func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	chainID, err := k.GetChainIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	ackErr := channeltypes.Acknowledgement_Error{}
+	if err := json.Unmarshal(acknowledgement, &ackErr); err == nil && ackErr.Error != "" {
+		return k.handleIcaOperationError(ctx, chainID, packet, ackErr.Error)
+	}
+
+	k.resolveIcaOperation(ctx, chainID, packet)
+
 	ack := channeltypes.Acknowledgement_Result{}
-	err := json.Unmarshal(acknowledgement, &ack)
+	err = json.Unmarshal(acknowledgement, &ack)
 	if err != nil {
 		ackErr := channeltypes.Acknowledgement_Error{}
 		err := json.Unmarshal(acknowledgement, &ackErr)
@@ -120,6 +132,18 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 				return err
 			}
 			continue
+		case "/cosmos.staking.v1beta1.MsgUndelegate":
+			response := stakingtypes.MsgUndelegateResponse{}
+			err := proto.Unmarshal(msgData.Data, &response)
+			if err != nil {
+				k.Logger(ctx).Error("unable to unmarshal MsgUndelegate response", "error", err)
+				return err
+			}
+			k.Logger(ctx).Debug("Undelegation initiated", "response", response)
+			if err := k.HandleUndelegate(ctx, src, response.CompletionTime, packetData.Memo); err != nil {
+				return err
+			}
+			continue
 		case "/cosmos.bank.v1beta1.MsgSend":
 			response := banktypes.MsgSendResponse{}
 			err := proto.Unmarshal(msgData.Data, &response)
@@ -177,10 +201,215 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 	return nil
 }
 
+// HandleTimeout decodes the messages of a timed out ICA packet and dispatches each to compensating logic, so
+// that waitgroups are not left dangling, unbondings are retried in a subsequent epoch, and the escrowed qAssets of
+// failed tokenizations and payouts are refunded.
+//
+// An error returned from here fails the MsgTimeout, so that the packet cannot be timed out and its ordered channel is
+// never closed (and re-opened); errors are therefore logged, and the compensation of each message is applied in a
+// cached context that is discarded if it fails.
 func (k *Keeper) HandleTimeout(ctx sdk.Context, packet channeltypes.Packet) error {
+	var packetData icatypes.InterchainAccountPacketData
+	err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData)
+	if err != nil {
+		k.Logger(ctx).Error("unable to unmarshal timeout packet data", "error", err, "data", packetData)
+		return nil
+	}
+	msgs, err := icatypes.DeserializeCosmosTx(k.cdc, packetData.Data)
+	if err != nil {
+		k.Logger(ctx).Error("unable to decode messages", "err", err)
+		return nil
+	}
+
+	zone, err := k.GetZoneFromContext(ctx)
+	if err != nil {
+		// the zone may have been removed (e.g. once sunset); there is nothing left to compensate.
+		k.Logger(ctx).Error("unable to compensate timed out ICA packet", "port", packet.SourcePort, "sequence", packet.Sequence, "error", err)
+		return nil
+	}
+
+	k.timeoutIcaOperation(ctx, zone.ChainId, packet)
+
+	for _, src := range msgs {
+		k.Logger(ctx).Error("ICA packet timed out", "type", sdk.MsgTypeURL(src), "zone", zone.ChainId, "sequence", packet.Sequence)
+	}
+	k.compensateIcaMsgs(ctx, zone, msgs, packetData.Memo, types.EventTypeIcaTimeout)
+
 	return nil
 }
 
+// compensateIcaMsgs dispatches each message of a timed out or failed ICA operation to compensating logic, and emits
+// an event of the given type with the outcome. The compensation of each message is applied in a cached context that
+// is discarded if it fails, so that the failure is logged without preventing the compensation of other messages.
+func (k *Keeper) compensateIcaMsgs(ctx sdk.Context, zone *types.RegisteredZone, msgs []sdk.Msg, memo string, eventType string) {
+	for _, src := range msgs {
+		msgType := sdk.MsgTypeURL(src)
+
+		cacheCtx, write := ctx.CacheContext()
+		outcome, err := k.compensateIcaMsg(cacheCtx, zone, src, memo)
+		if err != nil {
+			k.Logger(ctx).Error("unable to compensate ICA message", "type", msgType, "zone", zone.ChainId, "memo", memo, "error", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(types.AttributeKeyConnectionID, zone.ConnectionId),
+				sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+				sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+				sdk.NewAttribute(types.AttributeKeyTimeoutOutcome, outcome),
+			),
+		})
+	}
+}
+
+// compensateIcaMsg compensates a single message of a timed out or failed ICA operation, returning the outcome.
+func (k *Keeper) compensateIcaMsg(ctx sdk.Context, zone *types.RegisteredZone, src sdk.Msg, memo string) (string, error) {
+	switch sdk.MsgTypeURL(src) {
+	case "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":
+		// treat as acknowledged, so the waitgroup is decremented and the distribution of withdrawn rewards is not blocked.
+		if err := k.HandleWithdrawRewards(ctx, src); err != nil {
+			return "", err
+		}
+		return types.AttributeValueTimeoutWaitgroupDecremented, nil
+	case "/cosmos.staking.v1beta1.MsgDelegate":
+		if err := k.handleDelegateTimeout(ctx, zone, src); err != nil {
+			return "", err
+		}
+		return types.AttributeValueTimeoutRequeued, nil
+	case "/cosmos.staking.v1beta1.MsgTokenizeShares":
+		refunded, err := k.failWithdrawalRecords(ctx, zone, []sdk.Msg{src}, memo)
+		if err != nil {
+			return "", err
+		}
+		if refunded {
+			return types.AttributeValueTimeoutRefunded, nil
+		}
+		return types.AttributeValueTimeoutRetained, nil
+	case "/cosmos.staking.v1beta1.MsgUndelegate":
+		if err := k.handleUndelegateTimeout(ctx, src, memo); err != nil {
+			return "", err
+		}
+		return types.AttributeValueTimeoutRequeued, nil
+	case "/cosmos.bank.v1beta1.MsgSend":
+		return k.handleSendTimeout(ctx, zone, src, memo)
+	default:
+		// funds (if any) remain in the originating account; rewards and deposits are picked up again by subsequent balance queries.
+		return types.AttributeValueTimeoutRetained, nil
+	}
+}
+
+// handleDelegateTimeout requeries the balance of the delegation account; any undelegated balance is delegated
+// once the balance callback is received.
+func (k *Keeper) handleDelegateTimeout(ctx sdk.Context, zone *types.RegisteredZone, msg sdk.Msg) error {
+	delegateMsg, ok := msg.(*stakingtypes.MsgDelegate)
+	if !ok {
+		return fmt.Errorf("unable to cast source message to MsgDelegate")
+	}
+
+	if _, err := zone.GetDelegationAccountByAddress(delegateMsg.DelegatorAddress); err != nil {
+		// performance account delegations are not retried.
+		return nil
+	}
+
+	return k.requeryAllBalances(ctx, zone, delegateMsg.DelegatorAddress)
+}
+
+// requeryAllBalances queries the balances of the given account of the zone; any undelegated balance of a delegation
+// account is delegated once the balance callback is received.
+func (k *Keeper) requeryAllBalances(ctx sdk.Context, zone *types.RegisteredZone, address string) error {
+	balanceQuery := banktypes.QueryAllBalancesRequest{Address: address}
+	bz, err := k.cdc.Marshal(&balanceQuery)
+	if err != nil {
+		return err
+	}
+
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"cosmos.bank.v1beta1.Query/AllBalances",
+		bz,
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"allbalances",
+		0,
+	)
+	return nil
+}
+
+// handleUndelegateTimeout returns the unbonding withdrawal records of the timed out batch to the queue, to be batched
+// again at the end of the epoch.
+func (k *Keeper) handleUndelegateTimeout(ctx sdk.Context, msg sdk.Msg, memo string) error {
+	undelegateMsg, ok := msg.(*stakingtypes.MsgUndelegate)
+	if !ok {
+		return fmt.Errorf("unable to cast source message to MsgUndelegate")
+	}
+
+	// NOTE: setting records mid-iteration breaks the iterator; cache the results and update retrospectively.
+	requeued := []types.WithdrawalRecord{}
+	k.IterateWithdrawalRecords(ctx, undelegateMsg.DelegatorAddress, func(_ int64, record types.WithdrawalRecord) bool {
+		if isUnbondingInBatch(record, undelegateMsg, memo) {
+			record.Status = types.WithdrawStatusQueued
+			record.UnbondingBatch = ""
+			requeued = append(requeued, record)
+		}
+		return false
+	})
+
+	for _, record := range requeued {
+		record := record
+		k.SetWithdrawalRecord(ctx, &record)
+	}
+	return nil
+}
+
+// handleSendTimeout fails the matching withdrawal record and refunds the escrowed qAssets backing it, returning the
+// outcome of the timeout. Refunds and transfers of deposits are requeued; sends from other accounts leave the funds in
+// place.
+func (k *Keeper) handleSendTimeout(ctx sdk.Context, zone *types.RegisteredZone, msg sdk.Msg, memo string) (string, error) {
+	sMsg, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		return "", fmt.Errorf("unable to cast source message to MsgSend")
+	}
+
+	if zone.DepositAddress != nil && sMsg.FromAddress == zone.DepositAddress.Address && isRefundMemo(memo) {
+		if k.handleRefundTimeout(ctx, zone, sMsg, memo) {
+			return types.AttributeValueTimeoutRequeued, nil
+		}
+		return types.AttributeValueTimeoutRetained, nil
+	}
+
+	// the delegation plans of a deposit remain until its transfer is acknowledged, and the transfer is resubmitted by
+	// HandleUntransferredDeposits on the next deposit interval.
+	if zone.DepositAddress != nil && sMsg.FromAddress == zone.DepositAddress.Address && zone.IsDelegateAddress(sMsg.ToAddress) {
+		if len(k.GetDelegationPlansForHash(ctx, zone, memo)) > 0 {
+			return types.AttributeValueTimeoutRequeued, nil
+		}
+		return types.AttributeValueTimeoutRetained, nil
+	}
+
+	if !zone.IsDelegateAddress(sMsg.FromAddress) {
+		return types.AttributeValueTimeoutRetained, nil
+	}
+
+	refunded, err := k.failWithdrawalRecords(ctx, zone, []sdk.Msg{sMsg}, memo)
+	if err != nil {
+		return "", err
+	}
+	if refunded {
+		return types.AttributeValueTimeoutRefunded, nil
+	}
+	return types.AttributeValueTimeoutRetained, nil
+}
+
 //----------------------------------------------------------------
 
 func (k *Keeper) HandleMsgTransfer(ctx sdk.Context, msg sdk.Msg) error {
@@ -198,14 +427,62 @@ func (k *Keeper) HandleMsgTransfer(ctx sdk.Context, msg sdk.Msg) error {
 		return nil
 	}
 
+	zone, err := k.GetZoneFromContext(ctx)
+	if err != nil {
+		k.Logger(ctx).Error(err.Error())
+		return err
+	}
+	// the token is denominated as on the host chain; fees are accounted per zone in host chain denoms.
+	k.AddZoneFees(ctx, zone.ChainId, sMsg.Token)
+
 	return k.HandleDistributeFeesFromModuleAccount(ctx)
 }
 
+// HandleDistributeFeesFromModuleAccount splits the fees held by the module account between the community pool,
+// the treasury address and stakers (via the fee collector), according to the module params. qAssets held in
+// escrow for pending redemptions are not fees, and are not distributed.
 func (k *Keeper) HandleDistributeFeesFromModuleAccount(ctx sdk.Context) error {
 	// what do we have in the account?
 	balance := k.BankKeeper.GetAllBalances(ctx, k.AccountKeeper.GetModuleAddress(types.ModuleName))
-	k.Logger(ctx).Info("distributing collected fees to stakers", "amount", balance)
-	return k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, balance) // Fee collector name needs to be passed in to keeper constructor.
+	k.IterateRegisteredZones(ctx, func(_ int64, zone types.RegisteredZone) bool {
+		balance = balance.Sub(sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, balance.AmountOf(zone.LocalDenom))))
+		return false
+	})
+	if balance.IsZero() {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	communityPool := sdk.NewCoins()
+	treasury := sdk.NewCoins()
+	for _, coin := range balance {
+		communityPool = communityPool.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(params.CommunityPoolFeeShare).TruncateInt()))
+		treasury = treasury.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(params.TreasuryFeeShare).TruncateInt()))
+	}
+	stakers := balance.Sub(communityPool).Sub(treasury)
+
+	k.Logger(ctx).Info("distributing collected fees", "stakers", stakers, "community_pool", communityPool, "treasury", treasury)
+
+	if !communityPool.IsZero() {
+		if err := k.DistrKeeper.FundCommunityPool(ctx, communityPool, k.AccountKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+			return err
+		}
+	}
+
+	if !treasury.IsZero() {
+		treasuryAddress, err := sdk.AccAddressFromBech32(params.TreasuryAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, treasuryAddress, treasury); err != nil {
+			return err
+		}
+	}
+
+	if stakers.IsZero() {
+		return nil
+	}
+	return k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, stakers) // Fee collector name needs to be passed in to keeper constructor.
 }
 
 func (k *Keeper) HandleCompleteMultiSend(ctx sdk.Context, msg sdk.Msg, memo string) error {
@@ -224,6 +501,16 @@ func (k *Keeper) HandleCompleteMultiSend(ctx sdk.Context, msg sdk.Msg, memo stri
 		return err
 	}
 
+	// rewards distributed from the withdrawal account to the delegation accounts.
+	if len(sMsg.Inputs) == 1 && zone.WithdrawalAddress != nil && sMsg.Inputs[0].Address == zone.WithdrawalAddress.GetAddress() {
+		for _, out := range sMsg.Outputs {
+			if err := k.handleRewardsDelegation(ctx, *zone, &banktypes.MsgSend{FromAddress: sMsg.Inputs[0].Address, ToAddress: out.Address, Amount: out.Coins}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	for _, out := range sMsg.Outputs {
 		accAddr, err := types.AccAddressFromBech32(out.Address, "")
 		if err != nil {
@@ -286,6 +573,8 @@ func (k *Keeper) HandleCompleteSend(ctx sdk.Context, msg sdk.Msg, memo string) e
 		return k.handleWithdrawForUser(ctx, zone, sMsg, memo)
 	case zone.IsDelegateAddress(sMsg.ToAddress) && zone.DepositAddress.Address == sMsg.FromAddress:
 		return k.handleSendToDelegate(ctx, zone, sMsg, memo)
+	case zone.DepositAddress.Address == sMsg.FromAddress && isRefundMemo(memo):
+		return k.handleCompleteRefund(ctx, zone, sMsg, memo)
 	default:
 		err = fmt.Errorf("unexpected completed send")
 		k.Logger(ctx).Error(err.Error())
@@ -294,6 +583,12 @@ func (k *Keeper) HandleCompleteSend(ctx sdk.Context, msg sdk.Msg, memo string) e
 }
 
 func (k *Keeper) handleRewardsDelegation(ctx sdk.Context, zone types.RegisteredZone, msg *banktypes.MsgSend) error {
+	// delegations of a sunsetting zone are being unbonded; rewards remain in the delegation account for redemption.
+	if zone.Sunsetting {
+		k.Logger(ctx).Info("zone is sunsetting; not delegating rewards", "zone", zone.ChainId, "delegator", msg.ToAddress, "amount", msg.Amount)
+		return nil
+	}
+
 	da, err := zone.GetDelegationAccountByAddress(msg.ToAddress)
 	if err != nil {
 		return err
@@ -337,33 +632,33 @@ func (k *Keeper) handleSendToDelegate(ctx sdk.Context, zone *types.RegisteredZon
 	return k.Delegate(ctx, *zone, da, plan)
 }
 
+// handleWithdrawForUser completes the withdrawal record matching an acknowledged send to the recipient, and settles
+// the escrow of the redemption.
 func (k *Keeper) handleWithdrawForUser(ctx sdk.Context, zone *types.RegisteredZone, msg *banktypes.MsgSend, memo string) error {
-	var err error
-	// first check for withdrawals (if FromAddress is a DelegateAccount)
-	k.IterateWithdrawalRecordsWithTxhash(ctx, memo, msg.FromAddress, func(idx int64, withdrawal types.WithdrawalRecord) bool {
-		if withdrawal.Recipient == msg.ToAddress {
-			k.Logger(ctx).Info("matched the recipient", "val", withdrawal.Delegator, "recipient", withdrawal.Recipient)
-			if msg.Amount[0].Amount.Equal(withdrawal.Amount.Amount) {
-				k.Logger(ctx).Info("matched the amount", "amount", msg.Amount, "record.amount", withdrawal.Amount.Amount)
-				if withdrawal.Status == WithdrawStatusSend {
-					k.Logger(ctx).Info("Found matching withdrawal; withdrawal marked as completed")
-					k.DeleteWithdrawalRecord(ctx, memo, withdrawal.Delegator, withdrawal.Validator, withdrawal.Recipient)
-					if len(k.AllWithdrawalRecordsWithHash(ctx, memo, withdrawal.Delegator)) == 0 {
-						err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{withdrawal.BurnAmount})
-						if err != nil {
-							return false
-						}
-						k.Logger(ctx).Info("burned coins post-withdrawal", "coins", withdrawal.BurnAmount)
-					}
-
-					err = k.EmitValsetRequery(ctx, zone.ConnectionId, zone.ChainId)
-					return err != nil
-				}
-			}
+	// NOTE: setting records mid-iteration breaks the iterator; find the matching record and update it retrospectively.
+	var matched *types.WithdrawalRecord
+	k.IterateWithdrawalRecordsWithTxhash(ctx, memo, msg.FromAddress, func(_ int64, withdrawal types.WithdrawalRecord) bool {
+		// a single MsgSend completes a single withdrawal record.
+		if withdrawal.Status == types.WithdrawStatusSend && withdrawal.Recipient == msg.ToAddress && msg.Amount[0].Amount.Equal(withdrawal.Amount.Amount) {
+			matched = &withdrawal
+			return true
 		}
 		return false
 	})
-	return err
+	if matched == nil {
+		return nil
+	}
+
+	k.Logger(ctx).Info("Found matching withdrawal; withdrawal marked as completed", "delegator", matched.Delegator, "recipient", matched.Recipient, "amount", matched.Amount)
+	matched.Status = types.WithdrawStatusCompleted
+	matched.CompletionTime = ctx.BlockTime()
+	k.SetWithdrawalRecord(ctx, matched)
+
+	if err := k.SettleRedemptionEscrow(ctx, zone, memo); err != nil {
+		return err
+	}
+
+	return k.EmitValsetRequery(ctx, zone.ConnectionId, zone.ChainId)
 }
 
 func (k *Keeper) HandleTokenizedShares(ctx sdk.Context, msg sdk.Msg, amount sdk.Coin, memo string) error {
@@ -375,6 +670,9 @@ func (k *Keeper) HandleTokenizedShares(ctx sdk.Context, msg sdk.Msg, amount sdk.
 		k.Logger(ctx).Error("unable to cast source message to MsgTokenizeShares")
 		return fmt.Errorf("unable to cast source message to MsgTokenizeShares")
 	}
+	if memo == DrainMemo {
+		return k.handleDrainTokenizedShares(ctx, tsMsg, amount)
+	}
 	// here we are either withdrawing for a user _or_ rebalancing internally. lets check both action queues:
 	k.IterateWithdrawalRecordsWithTxhash(ctx, memo, tsMsg.DelegatorAddress, func(idx int64, withdrawal types.WithdrawalRecord) bool {
 		k.Logger(ctx).Debug("iterating withdraw record", "idx", idx, "record", withdrawal)
@@ -382,7 +680,7 @@ func (k *Keeper) HandleTokenizedShares(ctx sdk.Context, msg sdk.Msg, amount sdk.
 			k.Logger(ctx).Debug("matched the prefix", "token", amount.Denom, "denom", "val", withdrawal.Validator)
 			if amount.Amount.Equal(withdrawal.Amount.Amount) {
 				k.Logger(ctx).Debug("matched the amount", "amount", amount.Amount, "record.amount", withdrawal.Amount.Amount)
-				if withdrawal.Status == WithdrawStatusTokenize {
+				if withdrawal.Status == types.WithdrawStatusTokenize {
 					k.Logger(ctx).Info("Found matching withdrawal", "request_amount", withdrawal.Amount, "actual_amount", amount)
 					// bingo!
 					_, delegatorIca := k.GetICAForDelegateAccount(ctx, withdrawal.Delegator)
@@ -398,7 +696,7 @@ func (k *Keeper) HandleTokenizedShares(ctx sdk.Context, msg sdk.Msg, amount sdk.
 						return true
 					}
 					k.Logger(ctx).Info("sending funds", "from", withdrawal.Delegator, "to", withdrawal.Recipient, "amount", amount)
-					withdrawal.Status = WithdrawStatusSend
+					withdrawal.Status = types.WithdrawStatusSend
 					k.SetWithdrawalRecord(ctx, &withdrawal)
 					return true
 				}
@@ -410,10 +708,6 @@ func (k *Keeper) HandleTokenizedShares(ctx sdk.Context, msg sdk.Msg, amount sdk.
 	return err
 }
 
-func (k *Keeper) HandleBeginRedelegate(ctx sdk.Context, msg sdk.Msg, completion time.Time) error {
-	panic("not implemented")
-}
-
 func (k *Keeper) HandleRedeemTokens(ctx sdk.Context, msg sdk.Msg, amount sdk.Coin) error {
 	k.Logger(ctx).Info("Received MsgRedeemTokensforShares acknowledgement")
 	// first, type assertion. we should have stakingtypes.MsgRedeemTokensforShares
@@ -659,10 +953,16 @@ func DistributeRewardsFromWithdrawAccount(k Keeper, ctx sdk.Context, args []byte
 		return err
 	}
 	baseDenomAmount := withdrawBalance.Balances.AmountOf(zone.BaseDenom)
-	// calculate fee (fee = amount * rate)
 
-	baseDenomFee := baseDenomAmount.ToDec().
-		Mul(k.GetCommissionRate(ctx)).
+	// dust carried forward from the previous distribution has already been charged commission.
+	carriedDust := sdk.ZeroInt()
+	if !zone.RewardsDust.IsNil() {
+		carriedDust = sdk.MinInt(zone.RewardsDust, baseDenomAmount)
+	}
+
+	// calculate fee (fee = amount * rate)
+	baseDenomFee := baseDenomAmount.Sub(carriedDust).ToDec().
+		Mul(k.GetZoneCommissionRate(ctx, zone)).
 		TruncateInt()
 
 	// prepare rewards distribution
@@ -670,11 +970,13 @@ func DistributeRewardsFromWithdrawAccount(k Keeper, ctx sdk.Context, args []byte
 
 	dust, msgs := k.prepareRewardsDistributionMsgs(zone, rewards)
 
-	// subtract dust from rewards
+	// subtract dust from rewards; dust remains in the withdrawal account, and is carried forward to the next distribution.
 	rewards = rewards.SubAmount(dust)
+	zone.RewardsDust = dust
+	k.SetRegisteredZone(ctx, zone)
 
-	// multiDenomFee is the balance of withdrawal account minus the redelegated rewards.
-	multiDenomFee := withdrawBalance.Balances.Sub(sdk.Coins{rewards})
+	// multiDenomFee is the balance of withdrawal account minus the redelegated rewards and dust.
+	multiDenomFee := withdrawBalance.Balances.Sub(sdk.Coins{rewards.AddAmount(dust)})
 
 	channelReq := channeltypes.QueryConnectionChannelsRequest{Connection: zone.ConnectionId}
 	localChannelResp, err := k.IBCKeeper.ChannelKeeper.ConnectionChannels(sdk.WrapSDKContext(ctx), &channelReq)
@@ -717,7 +1019,16 @@ func DistributeRewardsFromWithdrawAccount(k Keeper, ctx sdk.Context, args []byte
 }
 
 func (k *Keeper) updateRedemptionRate(ctx sdk.Context, zone types.RegisteredZone, epochRewards sdk.Coin) {
+	if zone.Sunsetting {
+		// the redemption rate of a sunsetting zone is frozen.
+		k.Logger(ctx).Info("Zone is sunsetting; redemption rate is frozen", "zone", zone.ChainId, "rate", zone.RedemptionRate)
+		return
+	}
 	ratio := zone.GetDelegatedAmount().Add(epochRewards).Amount.ToDec().Quo(k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount.ToDec())
+	if err := zone.ValidateRedemptionRate(ratio); err != nil {
+		k.TripCircuitBreaker(ctx, zone, ratio, err)
+		return
+	}
 	k.Logger(ctx).Info("Epochly rewards", "coins", epochRewards)
 	k.Logger(ctx).Info("Last redemption rate", "rate", zone.LastRedemptionRate)
 	k.Logger(ctx).Info("Current redemption rate", "rate", zone.RedemptionRate)
@@ -728,23 +1039,61 @@ func (k *Keeper) updateRedemptionRate(ctx sdk.Context, zone types.RegisteredZone
 	k.SetRegisteredZone(ctx, zone)
 }
 
+// TripCircuitBreaker pauses minting and redemptions for the zone, leaving the redemption rate unchanged, after an
+// update breached the zone's redemption rate bounds. The pause is lifted by a ResumeZoneProposal.
+func (k *Keeper) TripCircuitBreaker(ctx sdk.Context, zone types.RegisteredZone, proposedRate sdk.Dec, reason error) {
+	k.Logger(ctx).Error("Redemption rate circuit breaker tripped; pausing minting and redemptions", "zone", zone.ChainId, "rate", zone.RedemptionRate, "proposed", proposedRate, "reason", reason)
+	zone.CircuitBreakerTripped = true
+	k.SetRegisteredZone(ctx, zone)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, zone.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyRedemptionRate, zone.RedemptionRate.String()),
+			sdk.NewAttribute(types.AttributeKeyProposedRate, proposedRate.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason.Error()),
+		),
+	})
+}
+
+// prepareRewardsDistributionMsgs returns the messages distributing rewards from the withdrawal account to the active
+// delegation accounts, weighted towards those with smaller delegated balances, and the undistributed dust. Zones
+// supporting MsgMultiSend receive a single message.
 func (k *Keeper) prepareRewardsDistributionMsgs(zone types.RegisteredZone, rewards sdk.Coin) (sdk.Int, []sdk.Msg) {
-	// todo: use multisend.
-	// todo: this will probably not want to be an equal distribution. we want to use this to even out the distribution between accounts.
 	var msgs []sdk.Msg
 
-	dust := rewards.Amount
-	portion := rewards.Amount.ToDec().Quo(sdk.NewDec(int64(len(zone.DelegationAddresses)))).TruncateInt()
-	for _, da := range zone.GetDelegationAccounts() {
+	allocations, dust := types.DetermineRewardsAllocation(zone.GetActiveDelegationAccounts(), rewards)
+	if len(allocations) == 0 {
+		return dust, msgs
+	}
+
+	if zone.SupportMultiSend() {
+		outputs := make([]banktypes.Output, 0, len(allocations))
+		for _, allocation := range allocations.Sorted() {
+			outputs = append(outputs, banktypes.Output{Address: allocation.Address, Coins: allocation.Amount})
+		}
+		msgs = append(msgs, &banktypes.MsgMultiSend{
+			Inputs:  []banktypes.Input{{Address: zone.WithdrawalAddress.GetAddress(), Coins: allocations.Sum()}},
+			Outputs: outputs,
+		})
+		return dust, msgs
+	}
+
+	for _, allocation := range allocations.Sorted() {
 		msgs = append(
 			msgs,
 			&banktypes.MsgSend{
 				FromAddress: zone.WithdrawalAddress.GetAddress(),
-				ToAddress:   da.GetAddress(),
-				Amount:      sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, portion)),
+				ToAddress:   allocation.Address,
+				Amount:      allocation.Amount,
 			},
 		)
-		dust = dust.Sub(portion)
 	}
 
 	return dust, msgs
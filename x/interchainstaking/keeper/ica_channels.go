@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// GetZoneForPort determines the zone for a given interchain account port.
+func (k Keeper) GetZoneForPort(ctx sdk.Context, portID string) (*types.RegisteredZone, error) {
+	connectionID, err := k.GetConnectionForPort(ctx, portID)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := k.GetChainID(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	zone, found := k.GetRegisteredZoneInfo(ctx, chainID)
+	if !found {
+		return nil, fmt.Errorf("no registered zone for chain id: %s", chainID)
+	}
+	return &zone, nil
+}
+
+// SetICAAccountUnavailable marks the interchain account for the given port as unavailable, once its (ordered)
+// channel has been closed. The channel is re-opened by ReopenClosedICAChannels.
+func (k Keeper) SetICAAccountUnavailable(ctx sdk.Context, portID string) error {
+	zone, err := k.GetZoneForPort(ctx, portID)
+	if err != nil {
+		return err
+	}
+	account, err := zone.GetICAAccountByPortName(portID)
+	if err != nil {
+		return err
+	}
+	if account.Unavailable {
+		return nil
+	}
+
+	k.Logger(ctx).Error("interchain account channel closed; marking account unavailable", "zone", zone.ChainId, "port", portID)
+	account.Unavailable = true
+	k.SetRegisteredZone(ctx, *zone)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeIcaChannelClosed,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, zone.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+		),
+	})
+	return nil
+}
+
+// ReopenClosedICAChannels re-registers the unavailable interchain accounts of a zone, once their channels have
+// closed and no channel handshake is already in progress on the port.
+func (k Keeper) ReopenClosedICAChannels(ctx sdk.Context, zone types.RegisteredZone) {
+	for _, account := range zone.GetICAAccounts() {
+		if !account.Unavailable {
+			continue
+		}
+
+		if _, found := k.ICAControllerKeeper.GetOpenActiveChannel(ctx, zone.ConnectionId, account.PortName); found {
+			// channel is not yet closed (e.g. the timeout is still being processed).
+			continue
+		}
+
+		if k.hasPendingChannelHandshake(ctx, zone.ConnectionId, account.PortName) {
+			continue
+		}
+
+		// NOTE: use a cached context, so a failed handshake does not leave partial state behind.
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.registerInterchainAccount(cacheCtx, zone.ConnectionId, strings.TrimPrefix(account.PortName, icatypes.PortPrefix)); err != nil {
+			k.Logger(ctx).Error("unable to re-open interchain account channel", "zone", zone.ChainId, "port", account.PortName, "err", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		k.Logger(ctx).Info("re-opening interchain account channel", "zone", zone.ChainId, "port", account.PortName)
+	}
+}
+
+// hasPendingChannelHandshake returns true if a channel on the given port and connection has been initialised but
+// not yet opened.
+func (k Keeper) hasPendingChannelHandshake(ctx sdk.Context, connectionID string, portID string) bool {
+	pending := false
+	k.IBCKeeper.ChannelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		if channel.PortId == portID && len(channel.ConnectionHops) > 0 && channel.ConnectionHops[0] == connectionID &&
+			(channel.State == channeltypes.INIT || channel.State == channeltypes.TRYOPEN) {
+			pending = true
+			return true
+		}
+		return false
+	})
+	return pending
+}
+
+// HandleReopenedICA marks the interchain account for the given port as available once its channel has been
+// re-opened. When all of the zone's accounts are available, deferred epoch processing and deposits are resumed.
+func (k Keeper) HandleReopenedICA(ctx sdk.Context, zone types.RegisteredZone, portID string) error {
+	account, err := zone.GetICAAccountByPortName(portID)
+	if err != nil {
+		return err
+	}
+	account.Unavailable = false
+	k.SetRegisteredZone(ctx, zone)
+
+	k.Logger(ctx).Info("interchain account channel re-opened", "zone", zone.ChainId, "port", portID)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeIcaChannelReopened,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, zone.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+		),
+	})
+
+	if !zone.IsAvailable() {
+		return nil
+	}
+
+	if zone.DeferredEpoch > 0 {
+		k.Logger(ctx).Info("resuming deferred epoch processing", "zone", zone.ChainId, "epoch", zone.DeferredEpoch)
+		k.HandleEpochForZone(ctx, zone, zone.DeferredEpoch)
+	}
+
+	// deposits are not processed while the zone has unavailable accounts; check for pending deposits now.
+	k.depositInterval(ctx)(0, zone)
+	return nil
+}
+
+// RestoreICAAccounts re-binds the interchain account ports of a zone imported from genesis, and restores the
+// interchain account addresses and port connections, so that the zone's existing channels can continue to be used.
+// Ports and accounts already restored by the interchain accounts module genesis are left untouched.
+func (k Keeper) RestoreICAAccounts(ctx sdk.Context, zone types.RegisteredZone) error {
+	for _, account := range zone.GetICAAccounts() {
+		if account.PortName == "" {
+			continue
+		}
+
+		if !k.ICAControllerKeeper.IsBound(ctx, account.PortName) {
+			portCap := k.ICAControllerKeeper.BindPort(ctx, account.PortName)
+			if err := k.ICAControllerKeeper.ClaimCapability(ctx, portCap, host.PortPath(account.PortName)); err != nil {
+				return fmt.Errorf("unable to claim capability for port %s: %w", account.PortName, err)
+			}
+		}
+
+		if _, found := k.ICAControllerKeeper.GetInterchainAccountAddress(ctx, zone.ConnectionId, account.PortName); !found && account.Address != "" {
+			k.ICAControllerKeeper.SetInterchainAccountAddress(ctx, zone.ConnectionId, account.PortName, account.Address)
+		}
+
+		k.SetConnectionForPort(ctx, zone.ConnectionId, account.PortName)
+	}
+	return nil
+}
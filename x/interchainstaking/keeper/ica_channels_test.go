@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestICAAccountUnavailableUntilReopened() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	portID := "icacontroller-" + s.chainB.ChainID + ".deposit"
+	app.InterchainstakingKeeper.SetConnectionForPort(ctx, s.path.EndpointA.ConnectionID, portID)
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, icstypes.RegisteredZone{
+		ChainId:        s.chainB.ChainID,
+		ConnectionId:   s.path.EndpointA.ConnectionID,
+		LocalDenom:     "uqatom",
+		BaseDenom:      "uatom",
+		DepositAddress: &icstypes.ICAAccount{Address: "cosmos1deposit", PortName: portID, Balance: sdk.Coins{}},
+	})
+
+	s.Require().NoError(app.InterchainstakingKeeper.SetICAAccountUnavailable(ctx, portID))
+
+	zone, found := app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().False(zone.IsAvailable())
+	s.Require().Error(app.InterchainstakingKeeper.SubmitTx(ctx, []sdk.Msg{}, zone.DepositAddress, ""))
+
+	s.Require().NoError(app.InterchainstakingKeeper.HandleReopenedICA(ctx, zone, portID))
+
+	zone, found = app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().True(zone.IsAvailable())
+}
+
+func (s *KeeperTestSuite) TestReopenClosedICAChannels() {
+	path := s.newICAPath(s.chainB.ChainID + ".deposit")
+	app := s.GetQuicksilverApp(s.chainA)
+	k := app.InterchainstakingKeeper
+	s.Require().NoError(app.ICAControllerKeeper.RegisterInterchainAccount(s.chainA.GetContext(), path.EndpointA.ConnectionID, s.chainB.ChainID+".deposit"))
+	portID, address := s.completeICAHandshake(path)
+
+	ctx := s.chainA.GetContext()
+	k.SetRegisteredZone(ctx, icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		DepositAddress:      &icstypes.ICAAccount{Address: address, PortName: portID, Balance: sdk.Coins{}},
+		DelegationAddresses: []*icstypes.ICAAccount{{Address: "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e", DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())}},
+	})
+
+	// a timeout closes the ordered channel (and the relayer confirms the closure on the host), and the epoch is deferred
+	// while the account is unavailable.
+	s.Require().NoError(path.EndpointA.SetChannelClosed())
+	s.Require().NoError(path.EndpointB.SetChannelClosed())
+	ctx = s.chainA.GetContext()
+	packet := channeltypes.Packet{SourcePort: portID, SourceChannel: path.EndpointA.ChannelID, Data: icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX}.GetBytes()}
+	s.Require().NoError(interchainstaking.NewIBCModule(k).OnTimeoutPacket(ctx, packet, nil))
+	k.AfterEpochEnd(ctx, "epoch", 2)
+	zone, found := k.GetRegisteredZoneInfo(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().False(zone.IsAvailable())
+	s.Require().Equal(int64(2), zone.DeferredEpoch)
+	s.Require().Zero(zone.WithdrawalWaitgroup)
+
+	initialised := func() int {
+		count := 0
+		app.IBCKeeper.ChannelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+			if channel.PortId == portID && channel.State == channeltypes.INIT {
+				count++
+			}
+			return false
+		})
+		return count
+	}
+
+	// the account is re-registered once; the pending handshake is not duplicated in subsequent blocks.
+	k.BeginBlocker(ctx)
+	s.Require().Equal(1, initialised())
+	k.BeginBlocker(ctx)
+	s.Require().Equal(1, initialised())
+
+	// once the channel is re-opened, the account is available and the deferred epoch is processed.
+	reopenedPortID, reopenedAddress := s.completeICAHandshake(path)
+	s.Require().Equal(portID, reopenedPortID)
+	s.Require().Equal(address, reopenedAddress)
+
+	ctx = s.chainA.GetContext()
+	zone, found = k.GetRegisteredZoneInfo(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().True(zone.IsAvailable())
+	s.Require().Zero(zone.DeferredEpoch)
+	s.Require().Equal(uint32(1), zone.WithdrawalWaitgroup)
+	s.Require().Zero(initialised())
+}
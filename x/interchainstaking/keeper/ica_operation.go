@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func getIcaOperationsKey(chainID string) []byte {
+	return append(types.KeyPrefixIcaOperation, []byte(chainID+"/")...)
+}
+
+func getIcaOperationKey(portID string, channelID string, sequence uint64) []byte {
+	return append([]byte(portID+"/"+channelID+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetIcaOperation returns the operation submitted to the given port and channel with the given sequence.
+func (k Keeper) GetIcaOperation(ctx sdk.Context, chainID string, portID string, channelID string, sequence uint64) (types.IcaOperation, bool) {
+	operation := types.IcaOperation{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getIcaOperationsKey(chainID))
+	bz := store.Get(getIcaOperationKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return operation, false
+	}
+	k.cdc.MustUnmarshal(bz, &operation)
+	return operation, true
+}
+
+// SetIcaOperation stores the operation.
+func (k Keeper) SetIcaOperation(ctx sdk.Context, operation types.IcaOperation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getIcaOperationsKey(operation.ChainId))
+	store.Set(getIcaOperationKey(operation.PortId, operation.ChannelId, operation.Sequence), k.cdc.MustMarshal(&operation))
+}
+
+// DeleteIcaOperation deletes the operation.
+func (k Keeper) DeleteIcaOperation(ctx sdk.Context, operation types.IcaOperation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getIcaOperationsKey(operation.ChainId))
+	store.Delete(getIcaOperationKey(operation.PortId, operation.ChannelId, operation.Sequence))
+}
+
+// IterateIcaOperations iterates through the unresolved operations of the given zone.
+func (k Keeper) IterateIcaOperations(ctx sdk.Context, chainID string, fn func(index int64, operation types.IcaOperation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getIcaOperationsKey(chainID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		operation := types.IcaOperation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &operation)
+		if fn(i, operation) {
+			break
+		}
+		i++
+	}
+}
+
+// AllIcaOperations returns every unresolved operation of the given zone.
+func (k Keeper) AllIcaOperations(ctx sdk.Context, chainID string) []types.IcaOperation {
+	operations := []types.IcaOperation{}
+	k.IterateIcaOperations(ctx, chainID, func(_ int64, operation types.IcaOperation) bool {
+		operations = append(operations, operation)
+		return false
+	})
+	return operations
+}
+
+// hasPendingIcaOperation returns true if an operation with the given memo awaits acknowledgement on the given port.
+func (k Keeper) hasPendingIcaOperation(ctx sdk.Context, chainID string, portID string, memo string) bool {
+	pending := false
+	k.IterateIcaOperations(ctx, chainID, func(_ int64, operation types.IcaOperation) bool {
+		pending = operation.Status == types.IcaOperationPending && operation.PortId == portID && operation.Memo == memo
+		return pending
+	})
+	return pending
+}
+
+// hasIcaOperationStatus returns true if the operation has one of the given statuses.
+func hasIcaOperationStatus(operation types.IcaOperation, statuses []types.IcaOperationStatus) bool {
+	for _, status := range statuses {
+		if operation.Status == status {
+			return true
+		}
+	}
+	return false
+}
+
+// PruneIcaOperations deletes the failed and timed out operations of the given zone that were last submitted more
+// than IcaOperationRetentionBlocks ago. Their messages have already been compensated, so they are retained only to
+// allow operators to inspect them.
+func (k Keeper) PruneIcaOperations(ctx sdk.Context, chainID string) {
+	expired := []types.IcaOperation{}
+	k.IterateIcaOperations(ctx, chainID, func(_ int64, operation types.IcaOperation) bool {
+		if operation.Status != types.IcaOperationPending && ctx.BlockHeight()-operation.Height > types.IcaOperationRetentionBlocks {
+			expired = append(expired, operation)
+		}
+		return false
+	})
+
+	// operations are deleted after iteration, as deleting mid-iteration breaks the iterator.
+	for _, operation := range expired {
+		k.DeleteIcaOperation(ctx, operation)
+	}
+}
+
+// GetMaxMsgsPerTx returns the maximum number of messages submitted in a single interchain account transaction,
+// falling back to the default for chains initialised before the parameter was introduced.
+func (k *Keeper) GetMaxMsgsPerTx(ctx sdk.Context) uint64 {
+	var out uint64
+	k.paramStore.GetIfExists(ctx, types.KeyMaxMsgsPerTx, &out)
+	if out == 0 {
+		return types.DefaultMaxMsgsPerTx
+	}
+	return out
+}
+
+// sendIcaOperation submits the messages as a single interchain account transaction over the active channel of the
+// given port, and records the pending operation against the sequence of the resulting packet.
+func (k *Keeper) sendIcaOperation(ctx sdk.Context, connectionID string, portID string, msgs []sdk.Msg, memo string, attempts uint32) error {
+	channelID, found := k.ICAControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", portID)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !found {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	chainID, err := k.GetChainID(ctx, connectionID)
+	if err != nil {
+		return err
+	}
+
+	data, err := icatypes.SerializeCosmosTx(k.cdc, msgs)
+	if err != nil {
+		return err
+	}
+
+	// validate memo < 256 bytes
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	// timeoutTimestamp set to max value with the unsigned bit shifted to sastisfy hermes timestamp conversion
+	// it is the responsibility of the auth module developer to ensure an appropriate timeout timestamp
+	timeoutTimestamp := ^uint64(0) >> 1
+	sequence, err := k.ICAControllerKeeper.SendTx(ctx, chanCap, connectionID, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return err
+	}
+
+	msgTypes := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		msgTypes = append(msgTypes, sdk.MsgTypeURL(msg))
+	}
+
+	k.SetIcaOperation(ctx, types.IcaOperation{
+		ChainId:   chainID,
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Memo:      memo,
+		Data:      data,
+		Attempts:  attempts,
+		MsgTypes:  msgTypes,
+		Height:    ctx.BlockHeight(),
+		Status:    types.IcaOperationPending,
+	})
+	return nil
+}
+
+// resolveIcaOperation removes the pending operation of a successfully acknowledged packet.
+func (k *Keeper) resolveIcaOperation(ctx sdk.Context, chainID string, packet channeltypes.Packet) {
+	if operation, found := k.GetIcaOperation(ctx, chainID, packet.SourcePort, packet.SourceChannel, packet.Sequence); found {
+		k.DeleteIcaOperation(ctx, operation)
+	}
+}
+
+// timeoutIcaOperation marks the operation of a timed out packet. The channel is closed by the timeout, so the
+// operation is not resubmitted; its messages are compensated by HandleTimeout.
+func (k *Keeper) timeoutIcaOperation(ctx sdk.Context, chainID string, packet channeltypes.Packet) {
+	if operation, found := k.GetIcaOperation(ctx, chainID, packet.SourcePort, packet.SourceChannel, packet.Sequence); found {
+		operation.Status = types.IcaOperationTimedOut
+		k.SetIcaOperation(ctx, operation)
+	}
+}
+
+// handleIcaOperationError resubmits the operation of a packet that was acknowledged with an error. The host chain
+// executes each packet atomically, so only the failed operation is resubmitted, up to MaxIcaOperationAttempts times;
+// thereafter it is marked as failed, and its messages are compensated as those of a timed out packet are.
+func (k *Keeper) handleIcaOperationError(ctx sdk.Context, chainID string, packet channeltypes.Packet, reason string) error {
+	operation, found := k.GetIcaOperation(ctx, chainID, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		k.Logger(ctx).Error("ICA transaction failed", "zone", chainID, "port", packet.SourcePort, "sequence", packet.Sequence, "error", reason)
+		return nil
+	}
+
+	if operation.Attempts >= types.MaxIcaOperationAttempts {
+		k.Logger(ctx).Error("ICA operation failed; giving up", "zone", chainID, "port", operation.PortId, "sequence", operation.Sequence, "attempts", operation.Attempts, "error", reason)
+		operation.Status = types.IcaOperationFailed
+		k.SetIcaOperation(ctx, operation)
+		// as for timeouts, compensation errors are logged rather than returned, so that the acknowledgement is not
+		// rejected and the ordered channel is not blocked.
+		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, operation.Data)
+		if err != nil {
+			k.Logger(ctx).Error("unable to decode messages", "err", err)
+			return nil
+		}
+		zone, found := k.GetRegisteredZoneInfo(ctx, chainID)
+		if !found {
+			k.Logger(ctx).Error("unable to compensate failed ICA operation; zone not found", "zone", chainID, "sequence", operation.Sequence)
+			return nil
+		}
+		k.compensateIcaMsgs(ctx, &zone, msgs, operation.Memo, types.EventTypeIcaFailure)
+		return nil
+	}
+
+	k.Logger(ctx).Error("ICA operation failed; resubmitting", "zone", chainID, "port", operation.PortId, "sequence", operation.Sequence, "attempts", operation.Attempts, "error", reason)
+	k.DeleteIcaOperation(ctx, operation)
+
+	msgs, err := icatypes.DeserializeCosmosTx(k.cdc, operation.Data)
+	if err != nil {
+		return err
+	}
+	connectionID, err := k.GetConnectionForPort(ctx, operation.PortId)
+	if err != nil {
+		return err
+	}
+	if err := k.sendIcaOperation(ctx, connectionID, operation.PortId, msgs, operation.Memo, operation.Attempts+1); err != nil {
+		return fmt.Errorf("unable to resubmit ica operation: %w", err)
+	}
+	return nil
+}
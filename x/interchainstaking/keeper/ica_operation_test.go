@@ -0,0 +1,300 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestSubmitTxSplitsIcaOperations() {
+	portID, address := s.openICAChannel(s.chainB.ChainID + ".deposit")
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	k := app.InterchainstakingKeeper
+
+	zone := icstypes.RegisteredZone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, LocalDenom: "uqatom", BaseDenom: "uatom"}
+	k.SetRegisteredZone(ctx, zone)
+	s.Require().Equal(icstypes.DefaultMaxMsgsPerTx, k.GetMaxMsgsPerTx(ctx))
+
+	params := k.GetParams(ctx)
+	params.MaxMsgsPerTx = 2
+	k.SetParams(ctx, params)
+
+	msgs := []sdk.Msg{}
+	for i := int64(1); i <= 5; i++ {
+		msgs = append(msgs, &banktypes.MsgSend{FromAddress: address, ToAddress: TestOwnerAddress, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(i)))})
+	}
+	s.Require().NoError(k.SubmitTx(ctx, msgs, &icstypes.ICAAccount{Address: address, PortName: portID}, "chunked"))
+
+	// five messages are submitted as three transactions of at most two messages, in order.
+	operations := k.AllIcaOperations(ctx, zone.ChainId)
+	s.Require().Len(operations, 3)
+	submitted := []sdk.Msg{}
+	for i, operation := range operations {
+		s.Require().Equal(uint64(i+1), operation.Sequence)
+		s.Require().Equal(portID, operation.PortId)
+		s.Require().Equal("chunked", operation.Memo)
+		s.Require().Equal(uint32(1), operation.Attempts)
+		s.Require().Equal(icstypes.IcaOperationPending, operation.Status)
+		operationMsgs, err := icatypes.DeserializeCosmosTx(app.AppCodec(), operation.Data)
+		s.Require().NoError(err)
+		s.Require().Len(operation.MsgTypes, len(operationMsgs))
+		submitted = append(submitted, operationMsgs...)
+	}
+	s.Require().Len(operations[2].MsgTypes, 1)
+	s.Require().Equal(msgs, submitted)
+}
+
+func (s *KeeperTestSuite) TestIcaOperationResolution() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, icstypes.RegisteredZone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, LocalDenom: "uqatom", BaseDenom: "uatom"})
+	s.Require().Equal(icstypes.DefaultMaxMsgsPerTx, app.InterchainstakingKeeper.GetMaxMsgsPerTx(ctx))
+
+	data, err := icatypes.SerializeCosmosTx(app.AppCodec(), []sdk.Msg{&banktypes.MsgSend{FromAddress: "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv", ToAddress: TestOwnerAddress, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)))}})
+	s.Require().NoError(err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+
+	operation := func(sequence uint64, attempts uint32) icstypes.IcaOperation {
+		return icstypes.IcaOperation{ChainId: s.chainB.ChainID, PortId: "icacontroller-test", ChannelId: "channel-1", Sequence: sequence, Data: data, Attempts: attempts, Status: icstypes.IcaOperationPending}
+	}
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{SourcePort: "icacontroller-test", SourceChannel: "channel-1", Sequence: sequence, Data: packetData.GetBytes()}
+	}
+	get := func(sequence uint64) (icstypes.IcaOperation, bool) {
+		return app.InterchainstakingKeeper.GetIcaOperation(ctx, s.chainB.ChainID, "icacontroller-test", "channel-1", sequence)
+	}
+
+	app.InterchainstakingKeeper.SetIcaOperation(ctx, operation(1, 1))
+	app.InterchainstakingKeeper.SetIcaOperation(ctx, operation(2, 1))
+	app.InterchainstakingKeeper.SetIcaOperation(ctx, operation(3, icstypes.MaxIcaOperationAttempts))
+	s.Require().Len(app.InterchainstakingKeeper.AllIcaOperations(ctx, s.chainB.ChainID), 3)
+
+	// a successful acknowledgement resolves only the matching operation.
+	txMsgData, err := (&sdk.TxMsgData{}).Marshal()
+	s.Require().NoError(err)
+	s.Require().NoError(app.InterchainstakingKeeper.HandleAcknowledgement(ctx, packet(1), channeltypes.NewResultAcknowledgement(txMsgData).Acknowledgement()))
+	_, found := get(1)
+	s.Require().False(found)
+	s.Require().Len(app.InterchainstakingKeeper.AllIcaOperations(ctx, s.chainB.ChainID), 2)
+
+	// a timed out operation is no longer pending.
+	s.Require().NoError(app.InterchainstakingKeeper.HandleTimeout(ctx, packet(2)))
+	timedOut, found := get(2)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.IcaOperationTimedOut, timedOut.Status)
+
+	// a failed operation that has exhausted its attempts is not resubmitted.
+	s.Require().NoError(app.InterchainstakingKeeper.HandleAcknowledgement(ctx, packet(3), channeltypes.NewErrorAcknowledgement("failed").Acknowledgement()))
+	for _, operation := range app.InterchainstakingKeeper.AllIcaOperations(ctx, s.chainB.ChainID) {
+		s.Require().NotEqual(icstypes.IcaOperationPending, operation.Status)
+	}
+	s.Require().Len(app.InterchainstakingKeeper.AllIcaOperations(ctx, s.chainB.ChainID), 2)
+}
+
+func (s *KeeperTestSuite) TestIcaOperationLifecycle() {
+	portID, address := s.openICAChannel(s.chainB.ChainID + ".deposit")
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	k := app.InterchainstakingKeeper
+
+	zone := icstypes.RegisteredZone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, LocalDenom: "uqatom", BaseDenom: "uatom"}
+	k.SetRegisteredZone(ctx, zone)
+
+	account := &icstypes.ICAAccount{Address: address, PortName: portID}
+	for i := int64(1); i <= 3; i++ {
+		msg := &banktypes.MsgSend{FromAddress: address, ToAddress: TestOwnerAddress, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(i)))}
+		s.Require().NoError(k.SubmitTx(ctx, []sdk.Msg{msg}, account, ""))
+	}
+	operations := k.AllIcaOperations(ctx, zone.ChainId)
+	s.Require().Len(operations, 3)
+
+	packet := func(operation icstypes.IcaOperation) channeltypes.Packet {
+		packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: operation.Data}
+		return channeltypes.Packet{SourcePort: operation.PortId, SourceChannel: operation.ChannelId, Sequence: operation.Sequence, Data: packetData.GetBytes()}
+	}
+	get := func(sequence uint64) (icstypes.IcaOperation, bool) {
+		return k.GetIcaOperation(ctx, zone.ChainId, portID, operations[0].ChannelId, sequence)
+	}
+	query := func(statuses ...icstypes.IcaOperationStatus) []icstypes.IcaOperation {
+		res, err := k.IcaOperations(sdk.WrapSDKContext(ctx), &icstypes.QueryIcaOperationsRequest{ChainId: zone.ChainId, Statuses: statuses})
+		s.Require().NoError(err)
+		return res.Operations
+	}
+
+	// a successful acknowledgement resolves the operation.
+	txMsgData, err := (&sdk.TxMsgData{}).Marshal()
+	s.Require().NoError(err)
+	s.Require().NoError(k.HandleAcknowledgement(ctx, packet(operations[0]), channeltypes.NewResultAcknowledgement(txMsgData).Acknowledgement()))
+	_, found := get(1)
+	s.Require().False(found)
+
+	// an error acknowledgement resubmits the operation under the next sequence of the channel.
+	s.Require().NoError(k.HandleAcknowledgement(ctx, packet(operations[1]), channeltypes.NewErrorAcknowledgement("failed").Acknowledgement()))
+	_, found = get(2)
+	s.Require().False(found)
+	resubmitted, found := get(4)
+	s.Require().True(found)
+	s.Require().Equal(uint32(2), resubmitted.Attempts)
+	s.Require().Equal(operations[1].Data, resubmitted.Data)
+	s.Require().Equal(icstypes.IcaOperationPending, resubmitted.Status)
+
+	// a timed out operation is retained for inspection.
+	s.Require().NoError(k.HandleTimeout(ctx, packet(operations[2])))
+	timedOut, found := get(3)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.IcaOperationTimedOut, timedOut.Status)
+
+	s.Require().Len(query(), 2)
+	s.Require().Equal([]icstypes.IcaOperation{resubmitted}, query(icstypes.IcaOperationPending))
+	s.Require().Equal([]icstypes.IcaOperation{timedOut}, query(icstypes.IcaOperationTimedOut, icstypes.IcaOperationFailed))
+	s.Require().Empty(query(icstypes.IcaOperationFailed))
+
+	_, err = k.IcaOperations(sdk.WrapSDKContext(ctx), &icstypes.QueryIcaOperationsRequest{ChainId: "unknown-1"})
+	s.Require().Error(err)
+
+	// resolved operations are retained until the retention period has elapsed; pending operations are never pruned.
+	k.PruneIcaOperations(ctx.WithBlockHeight(timedOut.Height+icstypes.IcaOperationRetentionBlocks), zone.ChainId)
+	s.Require().Len(query(), 2)
+	k.PruneIcaOperations(ctx.WithBlockHeight(timedOut.Height+icstypes.IcaOperationRetentionBlocks+1), zone.ChainId)
+	s.Require().Equal([]icstypes.IcaOperation{resubmitted}, query())
+}
+
+func (s *KeeperTestSuite) TestIcaOperationAttemptsExhausted() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	k := app.InterchainstakingKeeper
+
+	k.SetRegisteredZone(ctx, icstypes.RegisteredZone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, LocalDenom: "uqatom", BaseDenom: "uatom"})
+
+	data, err := icatypes.SerializeCosmosTx(app.AppCodec(), []sdk.Msg{&banktypes.MsgSend{FromAddress: "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv", ToAddress: TestOwnerAddress, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)))}})
+	s.Require().NoError(err)
+	operation := icstypes.IcaOperation{
+		ChainId:   s.chainB.ChainID,
+		PortId:    "icacontroller-test",
+		ChannelId: "channel-1",
+		Sequence:  1,
+		Data:      data,
+		Attempts:  icstypes.MaxIcaOperationAttempts,
+		MsgTypes:  []string{"/cosmos.bank.v1beta1.MsgSend"},
+		Height:    ctx.BlockHeight(),
+		Status:    icstypes.IcaOperationPending,
+	}
+	k.SetIcaOperation(ctx, operation)
+
+	// an operation that has exhausted its attempts is marked as failed, and not resubmitted.
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	packet := channeltypes.Packet{SourcePort: operation.PortId, SourceChannel: operation.ChannelId, Sequence: operation.Sequence, Data: packetData.GetBytes()}
+	s.Require().NoError(k.HandleAcknowledgement(ctx, packet, channeltypes.NewErrorAcknowledgement("failed").Acknowledgement()))
+	operations := k.AllIcaOperations(ctx, s.chainB.ChainID)
+	s.Require().Len(operations, 1)
+	s.Require().Equal(icstypes.IcaOperationFailed, operations[0].Status)
+	s.Require().Equal(icstypes.MaxIcaOperationAttempts, int(operations[0].Attempts))
+}
+
+func (s *KeeperTestSuite) TestIcaOperationFailureCompensation() {
+	depositPort, depositAddress := s.openICAChannel(s.chainB.ChainID + ".deposit")
+	delegatePort, delegateAddress := s.openICAChannel(s.chainB.ChainID + ".delegate.0")
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	k := app.InterchainstakingKeeper
+
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	recipient := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	amount := sdk.NewCoin("uatom", sdk.NewInt(1000))
+
+	zone := icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		AccountPrefix:       "cosmos",
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		RedemptionRate:      sdk.OneDec(),
+		LastRedemptionRate:  sdk.OneDec(),
+		DepositAddress:      &icstypes.ICAAccount{Address: depositAddress, PortName: depositPort},
+		DelegationAddresses: []*icstypes.ICAAccount{{Address: delegateAddress, PortName: delegatePort, DelegatedBalance: amount}},
+	}
+	k.SetRegisteredZone(ctx, zone)
+
+	pending := func(memo string) []icstypes.IcaOperation {
+		operations := []icstypes.IcaOperation{}
+		for _, operation := range k.AllIcaOperations(ctx, zone.ChainId) {
+			if operation.Status == icstypes.IcaOperationPending && operation.Memo == memo {
+				operations = append(operations, operation)
+			}
+		}
+		return operations
+	}
+	// fail acknowledges the pending operation with the given memo with an error, once it has exhausted its attempts.
+	fail := func(memo string) {
+		operations := pending(memo)
+		s.Require().Len(operations, 1)
+		operation := operations[0]
+		operation.Attempts = icstypes.MaxIcaOperationAttempts
+		k.SetIcaOperation(ctx, operation)
+		packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: operation.Data, Memo: operation.Memo}
+		packet := channeltypes.Packet{SourcePort: operation.PortId, SourceChannel: operation.ChannelId, Sequence: operation.Sequence, Data: packetData.GetBytes()}
+		s.Require().NoError(k.HandleAcknowledgement(ctx, packet, channeltypes.NewErrorAcknowledgement("failed").Acknowledgement()))
+		s.Require().Empty(pending(memo))
+	}
+
+	// a failed refund is requeued, to be sent again on the next deposit interval.
+	k.AddRefund(ctx, zone, recipient, "rejected", sdk.NewCoins(amount), "invalid denom")
+	s.Require().NoError(k.HandlePendingRefunds(ctx, &zone))
+	refund, found := k.GetRefund(ctx, zone.ChainId, "rejected")
+	s.Require().True(found)
+	s.Require().Equal(icstypes.RefundStatusSent, refund.Status)
+	fail(refund.Batch)
+	refund, found = k.GetRefund(ctx, zone.ChainId, "rejected")
+	s.Require().True(found)
+	s.Require().Equal(icstypes.RefundStatusPending, refund.Status)
+	s.Require().True(hasEvent(ctx, icstypes.EventTypeIcaFailure))
+
+	// a failed transfer of a deposit to the delegation account retains its delegation plans, and is resubmitted.
+	k.SetDelegationPlan(ctx, &zone, "deposit", icstypes.DelegationPlan{DelegatorAddress: delegateAddress, ValidatorAddress: validator, Value: sdk.NewCoins(amount)})
+	s.Require().NoError(k.TransferToDelegate(ctx, zone, icstypes.Allocations{{Address: delegateAddress, Amount: sdk.NewCoins(amount)}}, "deposit"))
+	s.Require().NoError(k.HandleUntransferredDeposits(ctx, &zone))
+	s.Require().Len(pending("deposit"), 1)
+	failed := pending("deposit")[0]
+	fail("deposit")
+	s.Require().Len(k.GetDelegationPlansForHash(ctx, &zone, "deposit"), 1)
+	s.Require().NoError(k.HandleUntransferredDeposits(ctx, &zone))
+	resubmitted := pending("deposit")
+	s.Require().Len(resubmitted, 1)
+	s.Require().Equal(failed.Data, resubmitted[0].Data)
+
+	// a failed sunset unbonding requeues its withdrawals, and is issued again at the next epoch.
+	k.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegateAddress, validator, amount))
+	k.AddWithdrawalRecord(ctx, delegateAddress, validator, recipient, amount, sdk.NewCoin("uqatom", amount.Amount), "redemption", icstypes.WithdrawStatusQueued)
+	s.Require().NoError(k.SunsetZone(ctx, &zone))
+	record, found := k.GetWithdrawalRecord(ctx, "redemption", delegateAddress, validator, recipient)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.WithdrawStatusUnbond, record.Status)
+	fail(icskeeper.SunsetMemo)
+	record, found = k.GetWithdrawalRecord(ctx, "redemption", delegateAddress, validator, recipient)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.WithdrawStatusQueued, record.Status)
+
+	zone, found = k.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	s.Require().True(found)
+	k.HandleSunsetEpoch(ctx, zone)
+	s.Require().Len(pending(icskeeper.SunsetMemo), 1)
+	record, found = k.GetWithdrawalRecord(ctx, "redemption", delegateAddress, validator, recipient)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.WithdrawStatusUnbond, record.Status)
+}
@@ -99,11 +99,11 @@ func (k Keeper) AllOrdinalizedIntents(ctx sdk.Context, zone types.RegisteredZone
 	return intents
 }
 
-func (k *Keeper) AggregateIntents(ctx sdk.Context, zone types.RegisteredZone) {
+func (k *Keeper) AggregateIntents(ctx sdk.Context, zone *types.RegisteredZone) {
 	snapshot := false
 	intents := map[string]*types.ValidatorIntent{}
 	ordinalizedIntentSum := sdk.ZeroDec()
-	k.IterateIntents(ctx, zone, snapshot, func(_ int64, intent types.DelegatorIntent) (stop bool) {
+	k.IterateIntents(ctx, *zone, snapshot, func(_ int64, intent types.DelegatorIntent) (stop bool) {
 		query := bankTypes.QueryBalanceRequest{Address: intent.Delegator, Denom: zone.LocalDenom}
 		balance, err := k.BankKeeper.Balance(sdk.WrapSDKContext(ctx), &query)
 		if err != nil {
@@ -111,6 +111,9 @@ func (k *Keeper) AggregateIntents(ctx sdk.Context, zone types.RegisteredZone) {
 		}
 		baseBalance := zone.RedemptionRate.Mul(sdk.NewDecFromInt(balance.Balance.Amount)).TruncateInt()
 		for _, vIntent := range intent.Ordinalize(baseBalance).Intents {
+			if zone.IsValidatorExcluded(vIntent.ValoperAddress) {
+				continue
+			}
 			thisIntent, ok := intents[vIntent.ValoperAddress]
 			ordinalizedIntentSum = ordinalizedIntentSum.Add(vIntent.Weight)
 			if !ok {
@@ -130,10 +133,10 @@ func (k *Keeper) AggregateIntents(ctx sdk.Context, zone types.RegisteredZone) {
 	}
 
 	zone.AggregateIntent = intents
-	k.SetRegisteredZone(ctx, zone)
+	k.SetRegisteredZone(ctx, *zone)
 }
 
-func (k *Keeper) UpdateIntent(ctx sdk.Context, sender sdk.AccAddress, zone types.RegisteredZone, inAmount sdk.Coins, memo string) {
+func (k *Keeper) UpdateIntent(ctx sdk.Context, sender sdk.AccAddress, zone types.RegisteredZone, inAmount sdk.Coins, memoIntents types.ValidatorIntents) {
 	snapshot := false
 	// this is here because we need access to the bankKeeper to ordinalize intent
 	intent, _ := k.GetIntent(ctx, zone, sender.String(), snapshot)
@@ -146,7 +149,7 @@ func (k *Keeper) UpdateIntent(ctx sdk.Context, sender sdk.AccAddress, zone types
 	}
 	baseBalance := zone.RedemptionRate.Mul(sdk.NewDecFromInt(balance.Balance.Amount)).TruncateInt()
 	intent = intent.AddOrdinal(baseBalance, zone.ConvertCoinsToOrdinalIntents(inAmount))
-	intent = intent.AddOrdinal(baseBalance, zone.ConvertMemoToOrdinalIntents(inAmount, memo))
+	intent = intent.AddOrdinal(baseBalance, memoIntents)
 	if len(intent.Intents) == 0 {
 		return
 	}
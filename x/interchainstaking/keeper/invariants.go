@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// RegisterInvariants registers the interchainstaking module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "redemption-rate", RedemptionRateInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "delegated-balance", DelegatedBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "delegation-plans", DelegationPlansInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "redemption-escrow", RedemptionEscrowInvariant(k))
+}
+
+// AllInvariants runs all invariants of the interchainstaking module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{RedemptionRateInvariant(k), DelegatedBalanceInvariant(k), DelegationPlansInvariant(k), RedemptionEscrowInvariant(k)} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// RedemptionRateInvariant checks that the qAsset supply of each zone, valued at the zone's redemption rate, is within
+// RedemptionRateInvariantToleranceBasisPoints of the zone's delegated amount plus pending deposits and withdrawals and
+// the undelegated balances of its delegation accounts. qAssets escrowed for pending redemptions remain in supply until
+// burned; their underlying tokens are delegated until the undelegation is acknowledged, and are counted as pending
+// withdrawals thereafter. The tokens of a failed payout sit undelegated in the delegation account until they are
+// delegated again, so are counted in its balance.
+//
+// The redemption rate and delegated amounts are updated by acknowledgements and interchain queries in different
+// blocks, so zones with either in flight are skipped until they settle. Zones with a tripped circuit breaker are
+// skipped, as their redemption rate is known to be invalid, as are sunsetting zones, whose redemption rate is frozen
+// while their delegations are unbonded.
+func RedemptionRateInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		deviating := 0
+
+		tolerance := sdk.NewDecWithPrec(types.RedemptionRateInvariantToleranceBasisPoints, 4)
+
+		for _, zone := range k.AllRegisteredZones(ctx) {
+			zone := zone
+			if zone.CircuitBreakerTripped || zone.Sunsetting || zone.RedemptionRate.IsNil() || k.isZoneInFlight(ctx, &zone) {
+				continue
+			}
+
+			supply := k.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount
+			if !supply.IsPositive() {
+				continue
+			}
+
+			pending := sdk.ZeroInt()
+			k.IterateAllDelegationPlans(ctx, &zone, func(plan types.DelegationPlan, _ []byte) bool {
+				pending = pending.Add(plan.Value.AmountOf(zone.BaseDenom))
+				return false
+			})
+			for _, account := range zone.GetDelegationAccounts() {
+				pending = pending.Add(account.Balance.AmountOf(zone.BaseDenom))
+				k.IterateWithdrawalRecords(ctx, account.Address, func(_ int64, record types.WithdrawalRecord) bool {
+					if record.Status == types.WithdrawStatusSend || (record.Status == types.WithdrawStatusUnbond && !record.CompletionTime.IsZero()) {
+						pending = pending.Add(record.Amount.Amount)
+					}
+					return false
+				})
+			}
+
+			expected := supply.ToDec().Mul(zone.RedemptionRate)
+			actual := zone.GetDelegatedAmount().Amount.Add(pending).ToDec()
+			if expected.Sub(actual).Abs().GT(expected.Mul(tolerance)) {
+				deviating++
+				msg += fmt.Sprintf("\tzone %s: qAsset supply %s at redemption rate %s is %s, but delegated amount plus pending deposits, withdrawals and undelegated balances is %s\n", zone.ChainId, supply, zone.RedemptionRate, expected, actual)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "redemption-rate",
+			fmt.Sprintf("\t%d zones with redemption rate outside of tolerance\n%s", deviating, msg)), deviating != 0
+	}
+}
+
+// isZoneInFlight returns true if the zone has interchain account operations awaiting acknowledgement, one-off
+// interchain queries awaiting a response, or rewards awaiting withdrawal.
+func (k Keeper) isZoneInFlight(ctx sdk.Context, zone *types.RegisteredZone) bool {
+	if zone.WithdrawalWaitgroup > 0 {
+		return true
+	}
+
+	inFlight := false
+	k.IterateIcaOperations(ctx, zone.ChainId, func(_ int64, operation types.IcaOperation) bool {
+		inFlight = operation.Status == types.IcaOperationPending
+		return inFlight
+	})
+	if inFlight {
+		return true
+	}
+
+	k.ICQKeeper.IterateQueries(ctx, func(_ int64, query icqtypes.Query) bool {
+		inFlight = query.ChainId == zone.ChainId && query.Period.IsNegative()
+		return inFlight
+	})
+	return inFlight
+}
+
+// DelegatedBalanceInvariant checks that the delegated balance of each delegation account equals the sum of the
+// delegation records of the account.
+func DelegatedBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+
+		for _, zone := range k.AllRegisteredZones(ctx) {
+			zone := zone
+			sums := make(map[string]sdk.Int)
+			k.IterateAllDelegations(ctx, &zone, func(delegation types.Delegation) bool {
+				sum, ok := sums[delegation.DelegationAddress]
+				if !ok {
+					sum = sdk.ZeroInt()
+				}
+				sums[delegation.DelegationAddress] = sum.Add(delegation.Amount.Amount)
+				return false
+			})
+
+			for _, account := range zone.GetDelegationAccounts() {
+				delegated := sdk.ZeroInt()
+				if !account.DelegatedBalance.Amount.IsNil() {
+					delegated = account.DelegatedBalance.Amount
+				}
+				sum, ok := sums[account.Address]
+				if !ok {
+					sum = sdk.ZeroInt()
+				}
+				if !delegated.Equal(sum) {
+					broken++
+					msg += fmt.Sprintf("\tzone %s: delegation account %s has delegated balance %s, but delegation records sum to %s\n", zone.ChainId, account.Address, delegated, sum)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "delegated-balance",
+			fmt.Sprintf("\t%d delegation accounts with delegated balance not matching delegation records\n%s", broken, msg)), broken != 0
+	}
+}
+
+// DelegationPlansInvariant checks that every delegation plan belongs to the receipt of the deposit it was created for.
+func DelegationPlansInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+
+		for _, zone := range k.AllRegisteredZones(ctx) {
+			zone := zone
+			k.IterateAllDelegationPlans(ctx, &zone, func(plan types.DelegationPlan, key []byte) bool {
+				txhash, ok := delegationPlanTxhash(&zone, plan, key)
+				if !ok {
+					broken++
+					msg += fmt.Sprintf("\tzone %s: malformed delegation plan key %X\n", zone.ChainId, key)
+					return false
+				}
+				if _, found := k.GetReceipt(ctx, GetReceiptKey(zone, txhash)); !found {
+					broken++
+					msg += fmt.Sprintf("\tzone %s: delegation plan of %s to %s for %s has no receipt\n", zone.ChainId, plan.DelegatorAddress, plan.ValidatorAddress, txhash)
+				}
+				return false
+			})
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "delegation-plans",
+			fmt.Sprintf("\t%d delegation plans without a receipt\n%s", broken, msg)), broken != 0
+	}
+}
+
+// RedemptionEscrowInvariant checks that the module account holds the qAssets escrowed for each zone's pending
+// redemptions, less any amount already refunded.
+func RedemptionEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+
+		moduleAddress := k.AccountKeeper.GetModuleAddress(types.ModuleName)
+		for _, zone := range k.AllRegisteredZones(ctx) {
+			escrowed := sdk.ZeroInt()
+			for _, escrow := range k.AllRedemptionEscrows(ctx, zone.ChainId) {
+				escrowed = escrowed.Add(escrow.Amount.Amount.Sub(escrow.Refunded.Amount))
+			}
+
+			balance := k.BankKeeper.GetBalance(ctx, moduleAddress, zone.LocalDenom).Amount
+			if balance.LT(escrowed) {
+				broken++
+				msg += fmt.Sprintf("	zone %s: module account holds %s%s, but %s%s is escrowed\n", zone.ChainId, balance, zone.LocalDenom, escrowed, zone.LocalDenom)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "redemption-escrow",
+			fmt.Sprintf("\t%d zones with insufficient escrowed qAssets\n%s", broken, msg)), broken != 0
+	}
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestInvariants() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	k := app.InterchainstakingKeeper
+
+	delegator := "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv"
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	user, err := sdk.AccAddressFromBech32("cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a")
+	s.Require().NoError(err)
+
+	zone := icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		RedemptionRate:      sdk.OneDec(),
+		DelegationAddresses: []*icstypes.ICAAccount{{Address: delegator, PortName: s.chainB.ChainID + ".delegate.0", DelegatedBalance: sdk.NewCoin("uatom", sdk.NewInt(1000))}},
+	}
+	k.SetRegisteredZone(ctx, zone)
+	k.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegator, validator, sdk.NewCoin("uatom", sdk.NewInt(1000))))
+
+	mint := func(amount int64, recipient sdk.AccAddress) {
+		coins := sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(amount)))
+		s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, coins))
+		if recipient != nil {
+			s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, icstypes.ModuleName, recipient, coins))
+		}
+	}
+	mint(1000, user)
+
+	deviating := func() bool {
+		msg, broken := icskeeper.RedemptionRateInvariant(k)(ctx)
+		s.Require().Equal(broken, strings.Contains(msg, "zone "+zone.ChainId))
+		return broken
+	}
+
+	_, broken := icskeeper.AllInvariants(k)(ctx)
+	s.Require().False(broken)
+
+	// qAssets escrowed for a redemption are backed by their delegations until the undelegation is acknowledged, and
+	// by the pending withdrawal thereafter.
+	escrow := sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(500)))
+	k.SetRedemptionEscrow(ctx, icstypes.RedemptionEscrow{ChainId: zone.ChainId, Txhash: "redemption", Redeemer: user.String(), Amount: escrow[0], Refunded: sdk.NewCoin("uqatom", sdk.ZeroInt())})
+	_, broken = icskeeper.RedemptionEscrowInvariant(k)(ctx)
+	s.Require().True(broken)
+
+	s.Require().NoError(app.BankKeeper.SendCoinsFromAccountToModule(ctx, user, icstypes.ModuleName, escrow))
+	_, broken = icskeeper.AllInvariants(k)(ctx)
+	s.Require().False(broken)
+
+	k.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegator, validator, sdk.NewCoin("uatom", sdk.NewInt(500))))
+	zone.DelegationAddresses[0].DelegatedBalance = sdk.NewCoin("uatom", sdk.NewInt(500))
+	k.SetRegisteredZone(ctx, zone)
+	s.Require().True(deviating())
+
+	k.AddWithdrawalRecord(ctx, delegator, validator, user.String(), sdk.NewCoin("uatom", sdk.NewInt(500)), escrow[0], "redemption", icstypes.WithdrawStatusUnbond)
+	record, found := k.GetWithdrawalRecord(ctx, "redemption", delegator, validator, user.String())
+	s.Require().True(found)
+	record.CompletionTime = ctx.BlockTime().Add(time.Hour)
+	k.SetWithdrawalRecord(ctx, &record)
+	s.Require().False(deviating())
+
+	// the tokens of a failed payout sit undelegated in the delegation account until they are delegated again.
+	record.Status = icstypes.WithdrawStatusFailed
+	k.SetWithdrawalRecord(ctx, &record)
+	s.Require().True(deviating())
+
+	zone.DelegationAddresses[0].Balance = sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(500)))
+	k.SetRegisteredZone(ctx, zone)
+	s.Require().False(deviating())
+
+	k.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegator, validator, sdk.NewCoin("uatom", sdk.NewInt(1000))))
+	zone.DelegationAddresses[0].DelegatedBalance = sdk.NewCoin("uatom", sdk.NewInt(1000))
+	zone.DelegationAddresses[0].Balance = sdk.NewCoins()
+	k.SetRegisteredZone(ctx, zone)
+	s.Require().False(deviating())
+
+	// qAssets minted for a deposit that has not yet been delegated are backed by the pending delegation plan.
+	mint(200, user)
+	s.Require().True(deviating())
+
+	// zones with acknowledgements or queries in flight are not checked until they settle.
+	operation := icstypes.IcaOperation{ChainId: zone.ChainId, PortId: zone.DelegationAddresses[0].PortName, ChannelId: "channel-1", Sequence: 1, Status: icstypes.IcaOperationPending}
+	k.SetIcaOperation(ctx, operation)
+	s.Require().False(deviating())
+	k.DeleteIcaOperation(ctx, operation)
+	s.Require().True(deviating())
+
+	query := app.InterchainQueryKeeper.NewQuery(ctx, icstypes.ModuleName, zone.ConnectionId, zone.ChainId, "cosmos.bank.v1beta1.Query/AllBalances", []byte(delegator), sdk.NewInt(-1), "allbalances", 0)
+	app.InterchainQueryKeeper.SetQuery(ctx, *query)
+	s.Require().False(deviating())
+	app.InterchainQueryKeeper.DeleteQuery(ctx, query.Id)
+	s.Require().True(deviating())
+
+	k.SetReceipt(ctx, *k.NewReceipt(ctx, zone, user.String(), "hash", sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200)))))
+	k.SetDelegationPlan(ctx, &zone, "hash", icstypes.DelegationPlan{DelegatorAddress: delegator, ValidatorAddress: validator, Value: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(200)))})
+	_, broken = icskeeper.AllInvariants(k)(ctx)
+	s.Require().False(broken)
+
+	// delegation plans must not outlive their receipt.
+	k.DeleteReceipt(ctx, icskeeper.GetReceiptKey(zone, "hash"))
+	msg, broken := icskeeper.DelegationPlansInvariant(k)(ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "hash")
+
+	// delegation records must sum to the delegated balance of the account.
+	k.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegator, validator, sdk.NewCoin("uatom", sdk.NewInt(900))))
+	msg, broken = icskeeper.DelegatedBalanceInvariant(k)(ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, delegator)
+
+	// zones with a tripped circuit breaker are not checked against their redemption rate.
+	zone.CircuitBreakerTripped = true
+	zone.RedemptionRate = sdk.NewDec(2)
+	k.SetRegisteredZone(ctx, zone)
+	s.Require().False(deviating())
+}
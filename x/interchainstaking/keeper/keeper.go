@@ -13,13 +13,17 @@ import (
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/ingenuity-build/quicksilver/utils"
 	interchainquerykeeper "github.com/ingenuity-build/quicksilver/x/interchainquery/keeper"
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 
@@ -35,12 +39,14 @@ type Keeper struct {
 	ICQKeeper           interchainquerykeeper.Keeper
 	AccountKeeper       authKeeper.AccountKeeper
 	BankKeeper          bankkeeper.Keeper
+	DistrKeeper         distrkeeper.Keeper
 	IBCKeeper           ibckeeper.Keeper
+	TransferKeeper      ibctransferkeeper.Keeper
 	paramStore          paramtypes.Subspace
 }
 
 // NewKeeper returns a new instance of zones Keeper
-func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, accountKeeper authKeeper.AccountKeeper, bankKeeper bankkeeper.Keeper, icacontrollerkeeper icacontrollerkeeper.Keeper, scopedKeeper capabilitykeeper.ScopedKeeper, icqKeeper interchainquerykeeper.Keeper, ibcKeeper ibckeeper.Keeper, ps paramtypes.Subspace) Keeper {
+func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, accountKeeper authKeeper.AccountKeeper, bankKeeper bankkeeper.Keeper, distrKeeper distrkeeper.Keeper, icacontrollerkeeper icacontrollerkeeper.Keeper, scopedKeeper capabilitykeeper.ScopedKeeper, icqKeeper interchainquerykeeper.Keeper, ibcKeeper ibckeeper.Keeper, transferKeeper ibctransferkeeper.Keeper, ps paramtypes.Subspace) Keeper {
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
@@ -57,7 +63,9 @@ func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, accountKeeper authKeeper.
 		ICQKeeper:           icqKeeper,
 		BankKeeper:          bankKeeper,
 		AccountKeeper:       accountKeeper,
+		DistrKeeper:         distrKeeper,
 		IBCKeeper:           ibcKeeper,
+		TransferKeeper:      transferKeeper,
 		paramStore:          ps,
 	}
 }
@@ -125,7 +133,10 @@ func (k Keeper) AllPortConnections(ctx sdk.Context) (pcs []types.PortConnectionT
 // * some of these functions (or portions thereof) may be changed to single
 //   query type functions, dependent upon callback features / capabilities;
 
-func SetValidatorsForZone(k Keeper, ctx sdk.Context, zoneInfo types.RegisteredZone, data []byte) error {
+// SetValidatorsForZone handles a page of the zone's validators of a given status, fetching proofs of new and changed
+// validators. Subsequent pages are requested until the set is exhausted, at which point validators recorded with the
+// status that were absent from every page are marked unbonded.
+func SetValidatorsForZone(k Keeper, ctx sdk.Context, zoneInfo types.RegisteredZone, data []byte, request stakingTypes.QueryValidatorsRequest) error {
 	validatorsRes := stakingTypes.QueryValidatorsResponse{}
 	err := k.cdc.Unmarshal(data, &validatorsRes)
 	if err != nil {
@@ -133,7 +144,13 @@ func SetValidatorsForZone(k Keeper, ctx sdk.Context, zoneInfo types.RegisteredZo
 		return err
 	}
 
+	if request.Pagination == nil || len(request.Pagination.Key) == 0 {
+		// first page; start a new pass over the validators of this status.
+		k.ClearValsetPass(ctx, zoneInfo.ChainId, request.Status)
+	}
+
 	for _, validator := range validatorsRes.Validators {
+		k.SetValsetPassValidator(ctx, zoneInfo.ChainId, request.Status, validator.OperatorAddress)
 		_, addr, _ := bech32.DecodeAndConvert(validator.OperatorAddress)
 		val, err := zoneInfo.GetValidatorByValoper(validator.OperatorAddress)
 		if err != nil {
@@ -154,7 +171,8 @@ func SetValidatorsForZone(k Keeper, ctx sdk.Context, zoneInfo types.RegisteredZo
 			continue
 		}
 
-		if !val.CommissionRate.Equal(validator.GetCommission()) || !val.VotingPower.Equal(validator.Tokens) || !val.DelegatorShares.Equal(validator.DelegatorShares) {
+		if !val.CommissionRate.Equal(validator.GetCommission()) || !val.VotingPower.Equal(validator.Tokens) || !val.DelegatorShares.Equal(validator.DelegatorShares) ||
+			val.Jailed != validator.Jailed || val.Status != validator.Status.String() {
 			k.Logger(ctx).Info("Validator state change; fetching proof", "valoper", validator.OperatorAddress)
 
 			if err != nil {
@@ -176,12 +194,88 @@ func SetValidatorsForZone(k Keeper, ctx sdk.Context, zoneInfo types.RegisteredZo
 		}
 	}
 
-	// also do this for Unbonded and Unbonding
+	if validatorsRes.Pagination != nil && len(validatorsRes.Pagination.NextKey) > 0 {
+		return k.EmitValsetPageQuery(ctx, zoneInfo.ConnectionId, zoneInfo.ChainId, request.Status, validatorsRes.Pagination.NextKey)
+	}
+
+	k.completeValsetPass(ctx, &zoneInfo, request.Status)
+	k.SetRegisteredZone(ctx, zoneInfo)
+	return nil
+}
+
+// completeValsetPass marks validators recorded with the given status, but absent from the completed pass over the
+// validators of that status, as unbonded, and fetches proof of their current state. Validators that no longer exist
+// are removed when the proof is received.
+func (k Keeper) completeValsetPass(ctx sdk.Context, zone *types.RegisteredZone, status string) {
+	for _, val := range zone.GetValidatorsSorted() {
+		if val.Status != status || k.IsValsetPassValidator(ctx, zone.ChainId, status, val.ValoperAddress) {
+			continue
+		}
+		if status != stakingTypes.BondStatusUnbonded {
+			k.Logger(ctx).Info("Validator absent from validator set; marking unbonded", "zone", zone.ChainId, "valoper", val.ValoperAddress, "status", status)
+			val.Status = stakingTypes.BondStatusUnbonded
+		}
+
+		_, addr, err := bech32.DecodeAndConvert(val.ValoperAddress)
+		if err != nil {
+			continue
+		}
+		k.ICQKeeper.MakeRequest(
+			ctx,
+			zone.ConnectionId,
+			zone.ChainId,
+			"store/staking/key",
+			stakingTypes.GetValidatorKey(addr),
+			sdk.NewInt(-1),
+			types.ModuleName,
+			"validator",
+			0,
+		)
+	}
+	k.ClearValsetPass(ctx, zone.ChainId, status)
+}
+
+// RemoveValidatorForZone removes the validator with the given staking store key from the zone once it no longer exists
+// on the host chain, so that it is not queried on every valset pass. Validators with recorded delegations are retained
+// until the delegations have been removed.
+func RemoveValidatorForZone(k Keeper, ctx sdk.Context, zoneInfo types.RegisteredZone, key []byte) error {
+	if len(key) < 3 {
+		return fmt.Errorf("invalid validator key %X", key)
+	}
+	valoper, err := bech32.ConvertAndEncode(zoneInfo.AccountPrefix+"valoper", stakingTypes.AddressFromValidatorsKey(key))
+	if err != nil {
+		return err
+	}
+
+	delegated := false
+	k.IterateAllDelegations(ctx, &zoneInfo, func(delegation types.Delegation) bool {
+		delegated = delegation.ValidatorAddress == valoper
+		return delegated
+	})
+	if delegated {
+		k.Logger(ctx).Info("Validator no longer exists, but has delegations; retaining", "zone", zoneInfo.ChainId, "valoper", valoper)
+		return nil
+	}
+
+	validators := make([]*types.Validator, 0, len(zoneInfo.Validators))
+	for _, val := range zoneInfo.Validators {
+		if val.ValoperAddress == valoper {
+			k.Logger(ctx).Info("Validator no longer exists; removing", "zone", zoneInfo.ChainId, "valoper", valoper)
+			continue
+		}
+		validators = append(validators, val)
+	}
+	zoneInfo.Validators = validators
 	k.SetRegisteredZone(ctx, zoneInfo)
 	return nil
 }
 
 func SetValidatorForZone(k Keeper, ctx sdk.Context, zoneInfo types.RegisteredZone, data []byte) error {
+	if len(data) == 0 {
+		// the validator no longer exists on the host chain.
+		return nil
+	}
+
 	validator := stakingTypes.Validator{}
 	err := k.cdc.Unmarshal(data, &validator)
 	if err != nil {
@@ -189,21 +283,38 @@ func SetValidatorForZone(k Keeper, ctx sdk.Context, zoneInfo types.RegisteredZon
 		return err
 	}
 
+	consAddress := ""
+	if consAddr, err := validator.GetConsAddr(); err == nil {
+		consAddress, _ = bech32.ConvertAndEncode(zoneInfo.AccountPrefix+"valcons", consAddr)
+	}
+
 	val, err := zoneInfo.GetValidatorByValoper(validator.OperatorAddress)
 	if err != nil {
 		k.Logger(ctx).Info("Unable to find validator - adding...", "valoper", validator.OperatorAddress)
 
-		zoneInfo.Validators = append(zoneInfo.Validators, &types.Validator{
-			ValoperAddress:  validator.OperatorAddress,
-			CommissionRate:  validator.GetCommission(),
-			VotingPower:     validator.Tokens,
-			DelegatorShares: validator.DelegatorShares,
-			Score:           sdk.ZeroDec(),
-		})
+		val = &types.Validator{
+			ValoperAddress:   validator.OperatorAddress,
+			CommissionRate:   validator.GetCommission(),
+			VotingPower:      validator.Tokens,
+			DelegatorShares:  validator.DelegatorShares,
+			Score:            sdk.ZeroDec(),
+			Status:           validator.Status.String(),
+			Jailed:           validator.Jailed,
+			ConsensusAddress: consAddress,
+		}
+		zoneInfo.Validators = append(zoneInfo.Validators, val)
 		zoneInfo.Validators = zoneInfo.GetValidatorsSorted()
 
 	} else {
 
+		// a slash reduces the validator's tokens without reducing its shares, so a fall in the token to share ratio is
+		// a slash.
+		if !val.VotingPower.IsNil() && !val.DelegatorShares.IsNil() && val.DelegatorShares.IsPositive() && val.VotingPower.IsPositive() &&
+			!validator.Tokens.IsNil() && !validator.DelegatorShares.IsNil() && validator.DelegatorShares.IsPositive() &&
+			validator.Tokens.ToDec().Quo(validator.DelegatorShares).LT(val.VotingPower.ToDec().Quo(val.DelegatorShares)) {
+			k.HandleValidatorSlash(ctx, &zoneInfo, val.ValoperAddress, val.VotingPower, val.DelegatorShares, validator.Tokens, validator.DelegatorShares)
+		}
+
 		if validator.GetCommission().IsNil() || !val.CommissionRate.Equal(validator.GetCommission()) {
 			val.CommissionRate = validator.GetCommission()
 			k.Logger(ctx).Info("Validator commission rate change; updating...", "valoper", validator.OperatorAddress, "oldRate", val.CommissionRate, "newRate", validator.GetCommission())
@@ -218,15 +329,90 @@ func SetValidatorForZone(k Keeper, ctx sdk.Context, zoneInfo types.RegisteredZon
 			val.DelegatorShares = validator.DelegatorShares
 			k.Logger(ctx).Info("Validator delegator shares change; updating", "valoper", validator.OperatorAddress, "oldShares", val.DelegatorShares, "newShares", validator.DelegatorShares)
 		}
+
+		if val.Jailed != validator.Jailed || val.Status != validator.Status.String() {
+			k.Logger(ctx).Info("Validator status change; updating", "valoper", validator.OperatorAddress, "oldStatus", val.Status, "newStatus", validator.Status.String(), "jailed", validator.Jailed)
+			val.Jailed = validator.Jailed
+			val.Status = validator.Status.String()
+		}
+
+		if consAddress != "" {
+			val.ConsensusAddress = consAddress
+		}
+	}
+
+	// jailed validators may have been tombstoned; fetch the signing info to find out.
+	if val.Jailed && !val.Tombstoned && val.ConsensusAddress != "" {
+		_, consAddr, err := bech32.DecodeAndConvert(val.ConsensusAddress)
+		if err != nil {
+			return err
+		}
+		k.ICQKeeper.MakeRequest(
+			ctx,
+			zoneInfo.ConnectionId,
+			zoneInfo.ChainId,
+			"store/slashing/key",
+			slashingtypes.ValidatorSigningInfoKey(consAddr),
+			sdk.NewInt(-1),
+			types.ModuleName,
+			"signinginfo",
+			0,
+		)
 	}
 
 	k.SetRegisteredZone(ctx, zoneInfo)
 	return nil
 }
 
+// SetSigningInfoForZone records whether the validator of the signing info has been tombstoned.
+func SetSigningInfoForZone(k Keeper, ctx sdk.Context, zoneInfo types.RegisteredZone, data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	info := slashingtypes.ValidatorSigningInfo{}
+	if err := k.cdc.Unmarshal(data, &info); err != nil {
+		k.Logger(ctx).Error("unable to unmarshal validator signing info for zone", "zone", zoneInfo.ChainId, "err", err)
+		return err
+	}
+
+	for _, val := range zoneInfo.Validators {
+		if val.ConsensusAddress != info.Address {
+			continue
+		}
+		if info.Tombstoned && !val.Tombstoned {
+			k.Logger(ctx).Info("Validator tombstoned; updating", "valoper", val.ValoperAddress)
+			val.Tombstoned = true
+			k.SetRegisteredZone(ctx, zoneInfo)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("no validator found for consensus address %s", info.Address)
+}
+
 func (k Keeper) depositInterval(ctx sdk.Context) zoneItrFn {
 	return func(index int64, zoneInfo types.RegisteredZone) (stop bool) {
 		if zoneInfo.DepositAddress != nil {
+			if zoneInfo.DepositAddress.Unavailable {
+				k.Logger(ctx).Info("deposit account is unavailable; skipping deposit interval", "zone", zoneInfo.ChainId)
+				return false
+			}
+			// refunds are returned regardless of the zone state.
+			if err := k.HandlePendingRefunds(ctx, &zoneInfo); err != nil {
+				k.Logger(ctx).Error("unable to handle pending refunds", "zone", zoneInfo.ChainId, "err", err)
+			}
+			if zoneInfo.Sunsetting {
+				k.Logger(ctx).Info("zone is sunsetting; skipping deposit interval", "zone", zoneInfo.ChainId)
+				return false
+			}
+			if !zoneInfo.DepositsEnabled() {
+				k.Logger(ctx).Info("zone deposits are paused; skipping deposit interval", "zone", zoneInfo.ChainId)
+				return false
+			}
+			if err := k.HandleUntransferredDeposits(ctx, &zoneInfo); err != nil {
+				k.Logger(ctx).Error("unable to resubmit untransferred deposits", "zone", zoneInfo.ChainId, "err", err)
+			}
 			if !zoneInfo.DepositAddress.Balance.Empty() {
 				k.Logger(ctx).Info("balance is non zero", "balance", zoneInfo.DepositAddress.Balance)
 
@@ -253,8 +439,22 @@ func (k *Keeper) GetCommissionRate(ctx sdk.Context) sdk.Dec {
 	return out
 }
 
+// GetZoneCommissionRate returns the commission rate of the zone, falling back to the module commission rate for
+// zones registered before per-zone commission rates.
+func (k *Keeper) GetZoneCommissionRate(ctx sdk.Context, zone types.RegisteredZone) sdk.Dec {
+	if zone.CommissionRate.IsNil() {
+		return k.GetCommissionRate(ctx)
+	}
+	return zone.CommissionRate
+}
+
+// GetParams returns the module params. Params introduced after genesis are not present in the param store of
+// existing chains until they are first set, so each param falls back to its default if it is missing.
 func (k Keeper) GetParams(clientCtx sdk.Context) (params types.Params) {
-	k.paramStore.GetParamSet(clientCtx, &params)
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramStore.GetIfExists(clientCtx, pair.Key, pair.Value)
+	}
 	return params
 }
 
@@ -281,7 +481,7 @@ func (k Keeper) GetChainID(ctx sdk.Context, connectionID string) (string, error)
 }
 
 func (k Keeper) GetChainIDFromContext(ctx sdk.Context) (string, error) {
-	connectionID := ctx.Context().Value("connectionID")
+	connectionID := ctx.Context().Value(utils.ContextKey("connectionID"))
 	if connectionID == nil {
 		return "", fmt.Errorf("connectionID not in context")
 	}
@@ -6,6 +6,8 @@ import (
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	"github.com/stretchr/testify/suite"
 
@@ -107,6 +109,63 @@ func (s *KeeperTestSuite) SetupRegisteredZones() {
 	s.coordinator.CommitNBlocks(s.chainB, valsetInterval)
 }
 
+// openICAChannel opens an interchain account channel for the given owner over the suite's connection, and maps its
+// port to the connection; it returns the port and the address of the interchain account.
+func (s *KeeperTestSuite) openICAChannel(owner string) (string, string) {
+	path := s.newICAPath(owner)
+	s.Require().NoError(s.GetQuicksilverApp(s.chainA).ICAControllerKeeper.RegisterInterchainAccount(s.chainA.GetContext(), path.EndpointA.ConnectionID, owner))
+	return s.completeICAHandshake(path)
+}
+
+// newICAPath returns a path for an interchain account channel of the given owner over the suite's connection.
+func (s *KeeperTestSuite) newICAPath(owner string) *ibctesting.Path {
+	portID, err := icatypes.NewControllerPortID(owner)
+	s.Require().NoError(err)
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: s.path.EndpointA.ConnectionID,
+		HostConnectionId:       s.path.EndpointB.ConnectionID,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+
+	path := ibctesting.NewPath(s.chainA, s.chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.Order = channeltypes.ORDERED
+		endpoint.ChannelConfig.Version = version
+	}
+	path.EndpointA.ClientID = s.path.EndpointA.ClientID
+	path.EndpointA.ConnectionID = s.path.EndpointA.ConnectionID
+	path.EndpointA.ChannelConfig.PortID = portID
+	path.EndpointB.ClientID = s.path.EndpointB.ClientID
+	path.EndpointB.ConnectionID = s.path.EndpointB.ConnectionID
+	path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	return path
+}
+
+// completeICAHandshake completes the handshake of the most recently initialised channel on chain A over the path, and
+// maps its port to the connection; it returns the port and the address of the interchain account.
+func (s *KeeperTestSuite) completeICAHandshake(path *ibctesting.Path) (string, string) {
+	app := s.GetQuicksilverApp(s.chainA)
+	portID := path.EndpointA.ChannelConfig.PortID
+
+	channelSequence := app.IBCKeeper.ChannelKeeper.GetNextChannelSequence(s.chainA.GetContext())
+	s.chainA.App.Commit()
+	s.chainA.NextBlock()
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence - 1)
+	path.EndpointB.ChannelID = ""
+
+	s.Require().NoError(path.EndpointB.ChanOpenTry())
+	s.Require().NoError(path.EndpointA.ChanOpenAck())
+	s.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	ctx := s.chainA.GetContext()
+	address, found := app.ICAControllerKeeper.GetInterchainAccountAddress(ctx, path.EndpointA.ConnectionID, portID)
+	s.Require().True(found)
+	app.InterchainstakingKeeper.SetConnectionForPort(ctx, path.EndpointA.ConnectionID, portID)
+	return portID, address
+}
+
 func newQuicksilverPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 	path := ibctesting.NewPath(chainA, chainB)
 	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
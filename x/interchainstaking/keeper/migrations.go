@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates redemptions in flight to redemption escrows. Prior to escrows, redeemed qAssets were held by the
+// module account and the burn amount of the redemption was recorded on each of its withdrawal records; the qAssets
+// were burned once every withdrawal record of a delegation account had completed. Redemptions that have not been
+// burned are escrowed and settled, so that the share of failed withdrawals is refunded and the remainder is burned
+// once the redemption's withdrawals have completed or failed.
+//
+// The redeemer was not recorded, so refunds of migrated redemptions are paid to the local account of the recipient.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, zone := range m.keeper.AllRegisteredZones(ctx) {
+		zone := zone
+		for _, txhash := range m.keeper.unburnedRedemptions(ctx, &zone) {
+			if _, found := m.keeper.GetRedemptionEscrow(ctx, zone.ChainId, txhash); found {
+				continue
+			}
+			records := m.keeper.AllWithdrawalRecordsForRedemption(ctx, &zone, txhash)
+			recipient, err := types.AccAddressFromBech32(records[0].Recipient, "")
+			if err != nil {
+				m.keeper.Logger(ctx).Error("unable to migrate redemption", "zone", zone.ChainId, "hash", txhash, "error", err)
+				continue
+			}
+			escrow := types.RedemptionEscrow{
+				ChainId:  zone.ChainId,
+				Txhash:   txhash,
+				Redeemer: recipient.String(),
+				Amount:   records[0].BurnAmount,
+				Refunded: sdk.NewCoin(records[0].BurnAmount.Denom, sdk.ZeroInt()),
+			}
+			m.keeper.SetRedemptionEscrow(ctx, escrow)
+			m.keeper.Logger(ctx).Info("migrated redemption to escrow", "zone", zone.ChainId, "hash", txhash, "amount", escrow.Amount)
+			if err := m.keeper.SettleRedemptionEscrow(ctx, &zone, txhash); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// unburnedRedemptions returns the hashes of the zone's redemptions whose qAssets have not been burned; that is, those
+// for which no delegation account has completed every one of its withdrawal records.
+func (k Keeper) unburnedRedemptions(ctx sdk.Context, zone *types.RegisteredZone) []string {
+	hashes := []string{}
+	burned := map[string]bool{}
+	for _, da := range zone.GetDelegationAccounts() {
+		completed := map[string]bool{}
+		for _, record := range k.AllWithdrawalRecords(ctx, da.Address) {
+			if _, found := burned[record.Txhash]; !found {
+				burned[record.Txhash] = false
+				hashes = append(hashes, record.Txhash)
+			}
+			done, found := completed[record.Txhash]
+			completed[record.Txhash] = (done || !found) && record.Status == types.WithdrawStatusCompleted
+		}
+		for txhash, done := range completed {
+			burned[txhash] = burned[txhash] || done
+		}
+	}
+
+	unburned := []string{}
+	for _, txhash := range hashes {
+		if !burned[txhash] {
+			unburned = append(unburned, txhash)
+		}
+	}
+	return unburned
+}
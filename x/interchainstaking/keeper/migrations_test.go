@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestMigrateRedemptionEscrows() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	k := app.InterchainstakingKeeper
+
+	delegatorA := "cosmos1ent5eg0xn3pskf3fhdw8mky88ry7t4kx628ru3pzp4nqjp6eufusphlldy"
+	delegatorB := "cosmos1qa6ulh8q6gkxgxu7sxcrw2dr2l5fxz32fjm6y6vpmxpq4u8d2r7qdwxcqg"
+	recipient := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	recipientBz, err := icstypes.AccAddressFromBech32(recipient, "cosmos")
+	s.Require().NoError(err)
+
+	zone := icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		AccountPrefix:       "cosmos",
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		DelegationAddresses: []*icstypes.ICAAccount{{Address: delegatorA}, {Address: delegatorB}},
+	}
+	k.SetRegisteredZone(ctx, zone)
+
+	uatom := func(amount int64) sdk.Coin { return sdk.NewCoin("uatom", sdk.NewInt(amount)) }
+	uqatom := func(amount int64) sdk.Coin { return sdk.NewCoin("uqatom", sdk.NewInt(amount)) }
+
+	// pending: in flight across both delegation accounts.
+	k.AddWithdrawalRecord(ctx, delegatorA, "valoper1", recipient, uatom(60), uqatom(100), "pending", icstypes.WithdrawStatusUnbond)
+	k.AddWithdrawalRecord(ctx, delegatorB, "valoper1", recipient, uatom(40), uqatom(100), "pending", icstypes.WithdrawStatusQueued)
+	// burned: the records of one delegation account have completed, so the redemption was burned.
+	k.AddWithdrawalRecord(ctx, delegatorA, "valoper1", recipient, uatom(30), uqatom(30), "burned", icstypes.WithdrawStatusCompleted)
+	k.AddWithdrawalRecord(ctx, delegatorB, "valoper1", recipient, uatom(0), uqatom(30), "burned", icstypes.WithdrawStatusUnbond)
+	// failed: the withdrawal failed, so the redemption is refunded.
+	k.AddWithdrawalRecord(ctx, delegatorA, "valoper1", recipient, uatom(50), uqatom(50), "failed", icstypes.WithdrawStatusFailed)
+	// escrowed: already has an escrow, which is retained.
+	k.AddWithdrawalRecord(ctx, delegatorA, "valoper1", recipient, uatom(20), uqatom(20), "escrowed", icstypes.WithdrawStatusSend)
+	existing := icstypes.RedemptionEscrow{ChainId: zone.ChainId, Txhash: "escrowed", Redeemer: TestOwnerAddress, Amount: uqatom(20), Refunded: uqatom(0)}
+	k.SetRedemptionEscrow(ctx, existing)
+
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(uqatom(170))))
+
+	s.Require().NoError(icskeeper.NewMigrator(k).Migrate1to2(ctx))
+
+	escrow, found := k.GetRedemptionEscrow(ctx, zone.ChainId, "pending")
+	s.Require().True(found)
+	s.Require().Equal(icstypes.RedemptionEscrow{ChainId: zone.ChainId, Txhash: "pending", Redeemer: recipientBz.String(), Amount: uqatom(100), Refunded: uqatom(0)}, escrow)
+
+	_, found = k.GetRedemptionEscrow(ctx, zone.ChainId, "burned")
+	s.Require().False(found)
+
+	_, found = k.GetRedemptionEscrow(ctx, zone.ChainId, "failed")
+	s.Require().False(found)
+	s.Require().Equal(uqatom(50), app.BankKeeper.GetBalance(ctx, recipientBz, "uqatom"))
+
+	escrow, found = k.GetRedemptionEscrow(ctx, zone.ChainId, "escrowed")
+	s.Require().True(found)
+	s.Require().Equal(existing, escrow)
+
+	s.Require().Len(k.AllRedemptionEscrows(ctx, zone.ChainId), 2)
+	moduleAddress := app.AccountKeeper.GetModuleAddress(icstypes.ModuleName)
+	s.Require().Equal(uqatom(120), app.BankKeeper.GetBalance(ctx, moduleAddress, "uqatom"))
+}
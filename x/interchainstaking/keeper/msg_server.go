@@ -6,7 +6,6 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
-	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
@@ -64,9 +63,12 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 		return nil, fmt.Errorf("unable to find matching zone for denom %s", inCoin.GetDenom())
 	}
 
-	// does zone have LSM enabled?
-	if !zone.LiquidityModule {
-		return nil, fmt.Errorf("zone %s does not currently support redemptions", zone.ChainId)
+	if !zone.RedemptionsEnabled() {
+		return nil, fmt.Errorf("redemptions are paused for zone %s", zone.ChainId)
+	}
+
+	if zone.CircuitBreakerTripped {
+		return nil, fmt.Errorf("redemptions are paused for zone %s; redemption rate circuit breaker is tripped", zone.ChainId)
 	}
 
 	// does destination address match the prefix registered against the zone?
@@ -100,70 +102,61 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 	hash := sha256.Sum256(append(msg.GetSignBytes(), heightBytes...))
 	hashString := hex.EncodeToString(hash[:])
 
-	// lock qAssets - how are we tracking this?
+	if _, found := k.GetRedemptionEscrow(ctx, zone.ChainId, hashString); found {
+		return nil, fmt.Errorf("redemption %s already requested", hashString)
+	}
+
+	// escrow qAssets until the withdrawals of the redemption complete or fail (see SettleRedemptionEscrow).
 	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(inCoin)); err != nil {
 		return nil, err
 	}
+	k.SetRedemptionEscrow(ctx, types.RedemptionEscrow{
+		ChainId:  zone.ChainId,
+		Txhash:   hashString,
+		Redeemer: msg.FromAddress,
+		Amount:   inCoin,
+		Refunded: sdk.NewCoin(inCoin.Denom, sdk.ZeroInt()),
+	})
 
-	userIntent, found := k.GetIntent(ctx, *zone, msg.FromAddress, false)
-
-	if !found || len(userIntent.Intents) == 0 {
-		vi := []*types.ValidatorIntent{}
-		for _, v := range zone.GetAggregateIntentOrDefault() {
-			vi = append(vi, v)
+	var sumAmount sdk.Coins
+	if zone.Sunsetting {
+		// delegations of a sunsetting zone have been unbonded; redeem from the delegation account balances.
+		sumAmount, err = k.processSunsetRedemption(ctx, *zone, msg.DestinationAddress, outTokens, inCoin, hashString)
+		if err != nil {
+			return nil, err
 		}
-		userIntent = types.DelegatorIntent{Delegator: msg.FromAddress, Intents: vi}
-	}
-
-	intentMap := userIntent.ToAllocations(nativeTokens)
-
-	targets := k.GetRedemptionTargets(ctx, *zone, intentMap) // map[string][string]sdk.Coin
-
-	if len(targets) == 0 {
-		return nil, fmt.Errorf("targets can never be zero length")
-	}
-
-	sumAmount := sdk.NewCoins()
+	} else {
+		userIntent, found := k.GetIntent(ctx, *zone, msg.FromAddress, false)
 
-	msgs := make(map[string][]sdk.Msg, 0)
-
-	for _, target := range targets.Sorted() {
-		if len(target.Value) == 1 {
-			if _, ok := msgs[target.DelegatorAddress]; !ok {
-				msgs[target.DelegatorAddress] = make([]sdk.Msg, 0)
+		if !found || len(userIntent.Intents) == 0 {
+			vi := []*types.ValidatorIntent{}
+			for _, v := range zone.GetAggregateIntentOrDefault() {
+				vi = append(vi, v)
 			}
-			msgs[target.DelegatorAddress] = append(msgs[target.DelegatorAddress], &stakingtypes.MsgTokenizeShares{
-				DelegatorAddress:    target.DelegatorAddress,
-				ValidatorAddress:    target.ValidatorAddress,
-				Amount:              target.Value[0],
-				TokenizedShareOwner: msg.DestinationAddress,
-			})
-			sumAmount = sumAmount.Add(target.Value[0])
-			k.AddWithdrawalRecord(ctx, target.DelegatorAddress, target.ValidatorAddress, msg.DestinationAddress, target.Value[0], inCoin, hashString)
+			userIntent = types.DelegatorIntent{Delegator: msg.FromAddress, Intents: vi}
 		}
-	}
 
-	delegators := make([]string, 0, len(msgs))
-	for delegator := range msgs {
-		delegators = append(delegators, delegator)
-	}
-	sort.Strings(delegators)
+		intentMap := userIntent.ToAllocations(nativeTokens)
 
-	for _, delegator := range delegators {
-		icaAccount, err := zone.GetDelegationAccountByAddress(delegator)
-		if err != nil {
-			panic(err) // panic here because something is terribly wrong if we cann't find the delegation bucket here!!!
+		targets := k.GetRedemptionTargets(ctx, *zone, intentMap) // map[string][string]sdk.Coin
+
+		if len(targets) == 0 {
+			return nil, fmt.Errorf("targets can never be zero length")
 		}
-		err = k.SubmitTx(ctx, msgs[delegator], icaAccount, "")
-		if err != nil {
-			k.Logger(ctx).Error("error submitting tx", "err", err)
-			return nil, err
+
+		if zone.LiquidityModule {
+			sumAmount, err = k.processRedemptionForLsm(ctx, *zone, targets, msg.DestinationAddress, inCoin, hashString)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			sumAmount = k.queueRedemption(ctx, targets, msg.DestinationAddress, inCoin, hashString)
 		}
 	}
 
 	if !sumAmount.IsAllLTE(sdk.NewCoins(outTokens)) {
 		k.Logger(ctx).Error("output coins > than expected!", "sum", sumAmount, "expected", outTokens)
-		return nil, err
+		return nil, fmt.Errorf("output coins %s exceed expected %s", sumAmount, outTokens)
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -178,6 +171,7 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 			sdk.NewAttribute(types.AttributeKeyRecipientAddress, msg.DestinationAddress),
 			sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
 			sdk.NewAttribute(types.AttributeKeyConnectionID, zone.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyTxHash, hashString),
 		),
 	})
 
@@ -193,6 +187,10 @@ func (k msgServer) SignalIntent(goCtx context.Context, msg *types.MsgSignalInten
 		return nil, fmt.Errorf("invalid chain id \"%s\"", msg.ChainId)
 	}
 
+	if zone.IsPaused() {
+		return nil, fmt.Errorf("zone %s is paused", msg.ChainId)
+	}
+
 	// validate intents (aggregated errors)
 	if err := k.validateIntents(zone, msg.Intents); err != nil {
 		return nil, err
@@ -227,6 +225,10 @@ func (k msgServer) validateIntents(zone types.RegisteredZone, intents []*types.V
 		_, err := zone.GetValidatorByValoper(intent.ValoperAddress)
 		if err != nil {
 			errors[fmt.Sprintf("intent[%v]", i)] = err
+			continue
+		}
+		if zone.IsValidatorExcluded(intent.ValoperAddress) {
+			errors[fmt.Sprintf("intent[%v]", i)] = fmt.Errorf("validator %s is not eligible for delegation", intent.ValoperAddress)
 		}
 	}
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
@@ -33,7 +34,10 @@ func HandleRegisterZoneProposal(ctx sdk.Context, k Keeper, p *types.RegisterZone
 		LastRedemptionRate: sdk.NewDec(1),
 		MultiSend:          p.MultiSend,
 		LiquidityModule:    p.LiquidityModule,
+		CommissionRate:     k.GetCommissionRate(ctx),
 	}
+	delegateAccountCount := int(k.GetParam(ctx, types.KeyDelegateAccountCount))
+	zone.DelegationAccountCount = uint32(delegateAccountCount)
 	k.SetRegisteredZone(ctx, zone)
 
 	// generate deposit account
@@ -55,7 +59,6 @@ func HandleRegisterZoneProposal(ctx sdk.Context, k Keeper, p *types.RegisterZone
 	}
 
 	// generate delegate accounts
-	delegateAccountCount := int(k.GetParam(ctx, types.KeyDelegateAccountCount))
 	for i := 0; i < delegateAccountCount; i++ {
 		portOwner := fmt.Sprintf("%s.delegate.%d", chainID, i)
 		if err := k.registerInterchainAccount(ctx, zone.ConnectionId, portOwner); err != nil {
@@ -92,7 +95,8 @@ func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID string,
 	return nil
 }
 
-// HandleUpdateZoneProposal is a handler for executing a passed community spend proposal
+// HandleUpdateZoneProposal is a handler for executing a passed zone update proposal. All changes are validated
+// before any are applied; the proposal fails if any change is invalid.
 func HandleUpdateZoneProposal(ctx sdk.Context, k Keeper, p *types.UpdateZoneProposal) error {
 	zone, found := k.GetRegisteredZoneInfo(ctx, p.ChainId)
 	if !found {
@@ -101,17 +105,131 @@ func HandleUpdateZoneProposal(ctx sdk.Context, k Keeper, p *types.UpdateZoneProp
 	}
 
 	for _, change := range p.Changes {
-		if change.Key == "base_denom" {
-			if err := sdk.ValidateDenom(change.Value); err != nil {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+
+		switch change.Key {
+		case types.UpdateZoneKeyBaseDenom:
+			zone.BaseDenom = change.Value
+		case types.UpdateZoneKeyMultiSend:
+			zone.MultiSend, _ = strconv.ParseBool(change.Value)
+		case types.UpdateZoneKeyLiquidityModule:
+			zone.LiquidityModule, _ = strconv.ParseBool(change.Value)
+		case types.UpdateZoneKeyAccountPrefix:
+			zone.AccountPrefix = change.Value
+		case types.UpdateZoneKeyValidatorSelectionAllocation:
+			zone.ValidatorSelectionAllocation, _ = sdk.ParseCoinsNormalized(change.Value)
+		case types.UpdateZoneKeyHoldingsAllocation:
+			zone.HoldingsAllocation, _ = sdk.ParseCoinsNormalized(change.Value)
+		case types.UpdateZoneKeyCommissionRate:
+			zone.CommissionRate, _ = sdk.NewDecFromStr(change.Value)
+		case types.UpdateZoneKeyMaxRedemptionRateChange:
+			zone.MaxRedemptionRateChange, _ = sdk.NewDecFromStr(change.Value)
+		case types.UpdateZoneKeyMinRedemptionRate:
+			zone.MinRedemptionRate, _ = sdk.NewDecFromStr(change.Value)
+		case types.UpdateZoneKeyMaxRedemptionRate:
+			zone.MaxRedemptionRate, _ = sdk.NewDecFromStr(change.Value)
+		case types.UpdateZoneKeyState:
+			state, _ := types.ParseZoneState(change.Value)
+			if state == zone.State {
+				continue
+			}
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeZoneStateChange,
+				sdk.NewAttribute(types.AttributeKeyConnectionID, zone.ConnectionId),
+				sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+				sdk.NewAttribute(types.AttributeKeyPreviousState, types.ZoneStateName(zone.State)),
+				sdk.NewAttribute(types.AttributeKeyState, types.ZoneStateName(state)),
+			))
+			zone.State = state
+		case types.UpdateZoneKeyValidatorDenylist:
+			zone.ValidatorDenylist, _ = types.ParseValidatorList(change.Value)
+			if err := zone.ValidateValoperAddresses(zone.ValidatorDenylist); err != nil {
 				return err
 			}
-			zone.BaseDenom = change.Value
-			k.SetRegisteredZone(ctx, zone)
+		case types.UpdateZoneKeyValidatorAllowlist:
+			zone.ValidatorAllowlist, _ = types.ParseValidatorList(change.Value)
+			if err := zone.ValidateValoperAddresses(zone.ValidatorAllowlist); err != nil {
+				return err
+			}
+		case types.UpdateZoneKeyDelegationAccountCount:
+			count, _ := strconv.ParseUint(change.Value, 10, 32)
+			if err := k.SetDelegationAccountCount(ctx, &zone, uint32(count)); err != nil {
+				return fmt.Errorf("unable to set delegation account count: %w", err)
+			}
 		}
 	}
+	k.SetRegisteredZone(ctx, zone)
 
 	logger := k.Logger(ctx)
 	logger.Info("applied changes to zone", "changes", p.Changes, "zone", zone.ChainId)
 
 	return nil
 }
+
+// HandleDeregisterZoneProposal is a handler for executing a passed zone deregistration proposal. The zone is moved
+// into the sunsetting state, and is removed once all qAssets have been redeemed.
+func HandleDeregisterZoneProposal(ctx sdk.Context, k Keeper, p *types.DeregisterZoneProposal) error {
+	zone, found := k.GetRegisteredZoneInfo(ctx, p.ChainId)
+	if !found {
+		return fmt.Errorf("unable to get registered zone for chain id: %s", p.ChainId)
+	}
+
+	if zone.Sunsetting {
+		return fmt.Errorf("zone %s is already sunsetting", p.ChainId)
+	}
+
+	if err := k.SunsetZone(ctx, &zone); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeDeregisterZone,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, zone.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+		),
+	})
+
+	k.Logger(ctx).Info("zone is sunsetting", "zone", zone.ChainId, "redemption_rate", zone.RedemptionRate)
+	return nil
+}
+
+// HandleResumeZoneProposal is a handler for executing a passed zone resume proposal, which lifts the pause on
+// minting and redemptions set by the redemption rate circuit breaker.
+func HandleResumeZoneProposal(ctx sdk.Context, k Keeper, p *types.ResumeZoneProposal) error {
+	zone, found := k.GetRegisteredZoneInfo(ctx, p.ChainId)
+	if !found {
+		return fmt.Errorf("unable to get registered zone for chain id: %s", p.ChainId)
+	}
+
+	if !zone.CircuitBreakerTripped {
+		return fmt.Errorf("zone %s is not paused", p.ChainId)
+	}
+
+	// the rate prior to the pause is no longer a meaningful baseline; redemptions resume at the current rate.
+	zone.CircuitBreakerTripped = false
+	zone.LastRedemptionRate = zone.RedemptionRate
+	k.SetRegisteredZone(ctx, zone)
+	k.completeAcknowledgedIBCDeposits(ctx, zone)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeResumeZone,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, zone.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+		),
+	})
+
+	k.Logger(ctx).Info("zone resumed", "zone", zone.ChainId)
+	return nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestHandleUpdateZoneProposal() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone := icstypes.RegisteredZone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, LocalDenom: "uqatom", BaseDenom: "uatom", AccountPrefix: "cosmos"}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	proposal := icstypes.NewUpdateZoneProposal("update", "update zone", s.chainB.ChainID, []*icstypes.UpdateZoneValue{
+		{Key: icstypes.UpdateZoneKeyMultiSend, Value: "true"},
+		{Key: icstypes.UpdateZoneKeyLiquidityModule, Value: "true"},
+		{Key: icstypes.UpdateZoneKeyAccountPrefix, Value: "osmo"},
+		{Key: icstypes.UpdateZoneKeyHoldingsAllocation, Value: "5000uqck"},
+	})
+	s.Require().NoError(icskeeper.HandleUpdateZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+
+	zone, found := app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().True(zone.MultiSend)
+	s.Require().True(zone.LiquidityModule)
+	s.Require().Equal("osmo", zone.AccountPrefix)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("uqck", sdk.NewInt(5000))), zone.HoldingsAllocation)
+
+	// unknown keys reject the whole proposal.
+	proposal = icstypes.NewUpdateZoneProposal("update", "update zone", s.chainB.ChainID, []*icstypes.UpdateZoneValue{
+		{Key: icstypes.UpdateZoneKeyMultiSend, Value: "false"},
+		{Key: "local_denom", Value: "uqosmo"},
+	})
+	s.Require().Error(icskeeper.HandleUpdateZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+
+	// the zone's connection cannot be changed.
+	proposal = icstypes.NewUpdateZoneProposal("update", "update zone", s.chainB.ChainID, []*icstypes.UpdateZoneValue{
+		{Key: "connection_id", Value: s.path.EndpointA.ConnectionID},
+	})
+	s.Require().Error(icskeeper.HandleUpdateZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+
+	// validator lists must contain validators of the zone's chain.
+	_, bz, err := bech32.DecodeAndConvert("cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0")
+	s.Require().NoError(err)
+	valoper, err := bech32.ConvertAndEncode("osmovaloper", bz)
+	s.Require().NoError(err)
+	proposal = icstypes.NewUpdateZoneProposal("update", "update zone", s.chainB.ChainID, []*icstypes.UpdateZoneValue{
+		{Key: icstypes.UpdateZoneKeyValidatorDenylist, Value: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"},
+	})
+	s.Require().Error(icskeeper.HandleUpdateZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+	proposal = icstypes.NewUpdateZoneProposal("update", "update zone", s.chainB.ChainID, []*icstypes.UpdateZoneValue{
+		{Key: icstypes.UpdateZoneKeyValidatorAllowlist, Value: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"},
+	})
+	s.Require().Error(icskeeper.HandleUpdateZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+	proposal = icstypes.NewUpdateZoneProposal("update", "update zone", s.chainB.ChainID, []*icstypes.UpdateZoneValue{
+		{Key: icstypes.UpdateZoneKeyValidatorDenylist, Value: valoper},
+	})
+	s.Require().NoError(icskeeper.HandleUpdateZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+	zone, _ = app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, s.chainB.ChainID)
+	s.Require().Equal([]string{valoper}, zone.ValidatorDenylist)
+}
+
+func (s *KeeperTestSuite) TestCircuitBreakerAndResumeZoneProposal() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone := icstypes.RegisteredZone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, LocalDenom: "uqatom", BaseDenom: "uatom", RedemptionRate: sdk.OneDec(), LastRedemptionRate: sdk.NewDecWithPrec(9, 1)}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	app.InterchainstakingKeeper.TripCircuitBreaker(ctx, zone, sdk.NewDecWithPrec(5, 1), fmt.Errorf("rate dropped"))
+
+	zone, found := app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().True(zone.CircuitBreakerTripped)
+	s.Require().Equal(sdk.OneDec(), zone.RedemptionRate)
+
+	user := sdk.AccAddress([]byte("user________________"))
+	s.Require().Error(app.InterchainstakingKeeper.MintQAsset(ctx, user, zone, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)))))
+
+	proposal := icstypes.NewResumeZoneProposal("resume", "resume zone", s.chainB.ChainID)
+	s.Require().NoError(icskeeper.HandleResumeZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+
+	zone, found = app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().False(zone.CircuitBreakerTripped)
+	s.Require().Equal(sdk.OneDec(), zone.LastRedemptionRate)
+	s.Require().NoError(app.InterchainstakingKeeper.MintQAsset(ctx, user, zone, sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)))))
+
+	// zones that are not paused cannot be resumed.
+	s.Require().Error(icskeeper.HandleResumeZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+}
+
+func (s *KeeperTestSuite) TestUpdateZoneStateProposal() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone := icstypes.RegisteredZone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, LocalDenom: "uqatom", BaseDenom: "uatom", AccountPrefix: "cosmos"}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	proposal := icstypes.NewUpdateZoneProposal("pause", "pause deposits", s.chainB.ChainID, []*icstypes.UpdateZoneValue{
+		{Key: icstypes.UpdateZoneKeyState, Value: "deposits_paused"},
+	})
+	s.Require().NoError(icskeeper.HandleUpdateZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+
+	zone, found := app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.ZoneStateDepositsPaused, zone.State)
+	s.Require().False(zone.DepositsEnabled())
+	s.Require().True(zone.RedemptionsEnabled())
+
+	emitted := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == icstypes.EventTypeZoneStateChange {
+			emitted = true
+		}
+	}
+	s.Require().True(emitted)
+
+	// intents may not be signalled while the zone is paused.
+	proposal = icstypes.NewUpdateZoneProposal("pause", "pause zone", s.chainB.ChainID, []*icstypes.UpdateZoneValue{
+		{Key: icstypes.UpdateZoneKeyState, Value: "paused"},
+	})
+	s.Require().NoError(icskeeper.HandleUpdateZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+
+	msgServer := icskeeper.NewMsgServerImpl(app.InterchainstakingKeeper)
+	_, err := msgServer.SignalIntent(sdk.WrapSDKContext(ctx), &icstypes.MsgSignalIntent{ChainId: s.chainB.ChainID, FromAddress: "quick17dtl0mjt3t77kpuhg2edqzjpszulwhgzkcjrjl"})
+	s.Require().ErrorContains(err, "paused")
+}
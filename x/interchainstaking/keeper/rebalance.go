@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// Rebalance redelegates existing delegations towards the zone's aggregate intent. Redelegations are limited to
+// types.MaxRedelegationsPerEpoch messages per epoch, and delegations that are the destination of an incomplete
+// redelegation are skipped, as transitive redelegations are rejected by the host chain. Delegations to excluded
+// validators are redelegated away first, regardless of the rebalance threshold.
+func (k *Keeper) Rebalance(ctx sdk.Context, zone types.RegisteredZone) error {
+	if zone.Sunsetting {
+		return nil
+	}
+
+	total := zone.GetDelegatedAmount().Amount
+	if total.IsZero() {
+		return nil
+	}
+
+	currentState := k.GetDelegationBinsMap(ctx, &zone)
+
+	// validators we delegate to that are absent from the intent have a target weight of zero.
+	intent := types.ValidatorIntents{}
+	for valoper, valIntent := range zone.GetAggregateIntentOrDefault() {
+		intent[valoper] = valIntent
+	}
+	k.IterateAllDelegations(ctx, &zone, func(delegation types.Delegation) bool {
+		if _, ok := intent[delegation.ValidatorAddress]; !ok {
+			intent[delegation.ValidatorAddress] = &types.ValidatorIntent{ValoperAddress: delegation.ValidatorAddress, Weight: sdk.ZeroDec()}
+		}
+		return false
+	})
+
+	// ignore deltas below the threshold, so rewards accrual does not trigger constant redelegation.
+	threshold := total.MulRaw(types.RebalanceThresholdBasisPoints).QuoRaw(10000)
+
+	excluded := types.Diffs{}
+	sources := types.Diffs{}
+	targets := types.Diffs{}
+	// deltas are sorted by amount ascending; the most over-allocated validators come first.
+	for _, delta := range types.DetermineIntentDelta(currentState, total, intent) {
+		switch {
+		case delta.Amount.IsNegative() && zone.IsValidatorExcluded(delta.Valoper):
+			excluded = append(excluded, &types.Diff{Valoper: delta.Valoper, Amount: delta.Amount.Neg()})
+		case delta.Amount.IsNegative() && delta.Amount.Neg().GT(threshold):
+			sources = append(sources, &types.Diff{Valoper: delta.Valoper, Amount: delta.Amount.Neg()})
+		case delta.Amount.IsPositive() && delta.Amount.GT(threshold):
+			targets = append(types.Diffs{&types.Diff{Valoper: delta.Valoper, Amount: delta.Amount}}, targets...)
+		}
+	}
+
+	sources = append(excluded, sources...)
+
+	if len(sources) == 0 || len(targets) == 0 {
+		return nil
+	}
+
+	msgs := make(map[string][]sdk.Msg)
+	count := 0
+	now := ctx.BlockTime().Unix()
+
+SOURCES:
+	for _, source := range sources {
+		delegations := k.GetRedelegatableDelegations(ctx, &zone, source.Valoper, now)
+		for _, delegation := range delegations {
+			available := delegation.Amount.Amount
+			for _, target := range targets {
+				if count >= types.MaxRedelegationsPerEpoch {
+					break SOURCES
+				}
+				if source.Amount.IsZero() {
+					continue SOURCES
+				}
+				if available.IsZero() {
+					break
+				}
+				if target.Amount.IsZero() {
+					continue
+				}
+
+				amount := sdk.MinInt(sdk.MinInt(source.Amount, target.Amount), available)
+				msgs[delegation.DelegationAddress] = append(msgs[delegation.DelegationAddress], &stakingtypes.MsgBeginRedelegate{
+					DelegatorAddress:    delegation.DelegationAddress,
+					ValidatorSrcAddress: source.Valoper,
+					ValidatorDstAddress: target.Valoper,
+					Amount:              sdk.NewCoin(zone.BaseDenom, amount),
+				})
+				count++
+
+				source.Amount = source.Amount.Sub(amount)
+				target.Amount = target.Amount.Sub(amount)
+				available = available.Sub(amount)
+			}
+		}
+	}
+
+	delegators := make([]string, 0, len(msgs))
+	for delegator := range msgs {
+		delegators = append(delegators, delegator)
+	}
+	sort.Strings(delegators)
+
+	for _, delegator := range delegators {
+		account, err := zone.GetDelegationAccountByAddress(delegator)
+		if err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("Rebalancing delegations", "zone", zone.ChainId, "delegator", delegator, "msgs", len(msgs[delegator]))
+		if err := k.SubmitTx(ctx, msgs[delegator], account, fmt.Sprintf("rebalance/%d", ctx.BlockHeight())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetRedelegatableDelegations returns the non-zero delegations to a validator that are not the destination of an
+// incomplete redelegation, sorted by amount descending.
+func (k *Keeper) GetRedelegatableDelegations(ctx sdk.Context, zone *types.RegisteredZone, validator string, now int64) []types.Delegation {
+	delegations := []types.Delegation{}
+	k.IterateAllDelegations(ctx, zone, func(delegation types.Delegation) bool {
+		if delegation.ValidatorAddress == validator && delegation.RedelegationEnd <= now && delegation.Amount.IsPositive() {
+			delegations = append(delegations, delegation)
+		}
+		return false
+	})
+
+	sort.SliceStable(delegations, func(i, j int) bool {
+		if delegations[i].Amount.Amount.Equal(delegations[j].Amount.Amount) {
+			return delegations[i].DelegationAddress < delegations[j].DelegationAddress
+		}
+		return delegations[i].Amount.Amount.GT(delegations[j].Amount.Amount)
+	})
+	return delegations
+}
+
+// HandleBeginRedelegate moves the redelegated amount between the delegation records of an acknowledged
+// MsgBeginRedelegate, and records the completion time against the destination delegation.
+func (k *Keeper) HandleBeginRedelegate(ctx sdk.Context, msg sdk.Msg, completion time.Time) error {
+	k.Logger(ctx).Info("Received MsgBeginRedelegate acknowledgement")
+	// first, type assertion. we should have stakingtypes.MsgBeginRedelegate
+	redelegateMsg, ok := msg.(*stakingtypes.MsgBeginRedelegate)
+	if !ok {
+		k.Logger(ctx).Error("unable to cast source message to MsgBeginRedelegate")
+		return fmt.Errorf("unable to cast source message to MsgBeginRedelegate")
+	}
+
+	zone := k.GetZoneForDelegateAccount(ctx, redelegateMsg.DelegatorAddress)
+	if zone == nil {
+		return fmt.Errorf("unable to find zone for address %s", redelegateMsg.DelegatorAddress)
+	}
+
+	source, found := k.GetDelegation(ctx, zone, redelegateMsg.DelegatorAddress, redelegateMsg.ValidatorSrcAddress)
+	if found {
+		source.Amount = source.Amount.SubAmount(sdk.MinInt(source.Amount.Amount, redelegateMsg.Amount.Amount))
+		if source.Amount.IsZero() {
+			if err := k.RemoveDelegation(ctx, zone, source); err != nil {
+				return err
+			}
+		} else {
+			k.SetDelegation(ctx, zone, source)
+		}
+	}
+
+	destination, found := k.GetDelegation(ctx, zone, redelegateMsg.DelegatorAddress, redelegateMsg.ValidatorDstAddress)
+	if !found {
+		destination = types.NewDelegation(redelegateMsg.DelegatorAddress, redelegateMsg.ValidatorDstAddress, redelegateMsg.Amount)
+	} else {
+		destination.Amount = destination.Amount.Add(redelegateMsg.Amount)
+	}
+	destination.RedelegationEnd = completion.Unix()
+	k.SetDelegation(ctx, zone, destination)
+
+	k.Logger(ctx).Info("Redelegation initiated", "delegator", redelegateMsg.DelegatorAddress, "source", redelegateMsg.ValidatorSrcAddress, "destination", redelegateMsg.ValidatorDstAddress, "amount", redelegateMsg.Amount, "completion", completion)
+	return nil
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestHandleBeginRedelegate() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	delegator := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	validatorA := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	validatorB := "cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"
+
+	zone := icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		DelegationAddresses: []*icstypes.ICAAccount{{Address: delegator, DelegatedBalance: sdk.NewCoin("uatom", sdk.NewInt(1000))}},
+	}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+	app.InterchainstakingKeeper.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegator, validatorA, sdk.NewCoin("uatom", sdk.NewInt(1000))))
+
+	completion := ctx.BlockTime().Add(21 * 24 * time.Hour)
+	msg := &stakingtypes.MsgBeginRedelegate{DelegatorAddress: delegator, ValidatorSrcAddress: validatorA, ValidatorDstAddress: validatorB, Amount: sdk.NewCoin("uatom", sdk.NewInt(400))}
+	s.Require().NoError(app.InterchainstakingKeeper.HandleBeginRedelegate(ctx, msg, completion))
+
+	source, found := app.InterchainstakingKeeper.GetDelegation(ctx, &zone, delegator, validatorA)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(600), source.Amount.Amount)
+
+	destination, found := app.InterchainstakingKeeper.GetDelegation(ctx, &zone, delegator, validatorB)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(400), destination.Amount.Amount)
+	s.Require().Equal(completion.Unix(), destination.RedelegationEnd)
+
+	// the destination delegation cannot be redelegated until the redelegation completes.
+	s.Require().Len(app.InterchainstakingKeeper.GetRedelegatableDelegations(ctx, &zone, validatorB, ctx.BlockTime().Unix()), 0)
+	s.Require().Len(app.InterchainstakingKeeper.GetRedelegatableDelegations(ctx, &zone, validatorB, completion.Unix()), 1)
+}
+
+func (s *KeeperTestSuite) TestRebalance() {
+	valoper := func(i int) string {
+		address, err := bech32.ConvertAndEncode("cosmosvaloper", []byte(fmt.Sprintf("validator%011d", i)))
+		s.Require().NoError(err)
+		return address
+	}
+	redelegation := func(src int, dst int, amount int64) *stakingtypes.MsgBeginRedelegate {
+		return &stakingtypes.MsgBeginRedelegate{ValidatorSrcAddress: valoper(src), ValidatorDstAddress: valoper(dst), Amount: sdk.NewCoin("uatom", sdk.NewInt(amount))}
+	}
+	even := func(validators ...int) map[int]sdk.Dec {
+		weights := map[int]sdk.Dec{}
+		for _, validator := range validators {
+			weights[validator] = sdk.OneDec().QuoInt64(int64(len(validators)))
+		}
+		return weights
+	}
+
+	tests := []struct {
+		name        string
+		delegations map[int]int64
+		intent      map[int]sdk.Dec
+		denylist    []int
+		redelegated []int
+		sunsetting  bool
+		expected    []*stakingtypes.MsgBeginRedelegate
+		count       int
+	}{
+		{
+			name:        "deviation below threshold",
+			delegations: map[int]int64{0: 5020, 1: 4980},
+			intent:      even(0, 1),
+		},
+		{
+			name:        "deviation above threshold",
+			delegations: map[int]int64{0: 6000, 1: 4000},
+			intent:      even(0, 1),
+			expected:    []*stakingtypes.MsgBeginRedelegate{redelegation(0, 1, 1000)},
+		},
+		{
+			name:        "excluded validators drained first",
+			delegations: map[int]int64{0: 1000, 1: 7000, 2: 2000},
+			intent:      map[int]sdk.Dec{1: sdk.NewDecWithPrec(4, 1), 2: sdk.NewDecWithPrec(3, 1), 3: sdk.NewDecWithPrec(3, 1)},
+			denylist:    []int{0},
+			expected:    []*stakingtypes.MsgBeginRedelegate{redelegation(0, 3, 1000), redelegation(1, 3, 2000), redelegation(1, 2, 1000)},
+		},
+		{
+			name:        "redelegations capped per epoch",
+			delegations: map[int]int64{0: 21000},
+			intent:      even(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21),
+			count:       icstypes.MaxRedelegationsPerEpoch,
+		},
+		{
+			name:        "incomplete redelegation destinations skipped",
+			delegations: map[int]int64{0: 6000, 1: 4000},
+			intent:      even(0, 1),
+			redelegated: []int{0},
+		},
+		{
+			name:        "sunsetting zone",
+			delegations: map[int]int64{0: 6000, 1: 4000},
+			intent:      even(0, 1),
+			sunsetting:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			portID, address := s.openICAChannel(s.chainB.ChainID + ".delegate.0")
+
+			app := s.GetQuicksilverApp(s.chainA)
+			ctx := s.chainA.GetContext()
+			k := app.InterchainstakingKeeper
+
+			zone := icstypes.RegisteredZone{
+				ChainId:         s.chainB.ChainID,
+				ConnectionId:    s.path.EndpointA.ConnectionID,
+				LocalDenom:      "uqatom",
+				BaseDenom:       "uatom",
+				AggregateIntent: map[string]*icstypes.ValidatorIntent{},
+				Sunsetting:      tt.sunsetting,
+			}
+			for i := 0; i <= 21; i++ {
+				zone.Validators = append(zone.Validators, &icstypes.Validator{ValoperAddress: valoper(i), CommissionRate: sdk.ZeroDec(), VotingPower: sdk.NewInt(1000)})
+			}
+			for validator, weight := range tt.intent {
+				zone.AggregateIntent[valoper(validator)] = &icstypes.ValidatorIntent{ValoperAddress: valoper(validator), Weight: weight}
+			}
+			for _, validator := range tt.denylist {
+				zone.ValidatorDenylist = append(zone.ValidatorDenylist, valoper(validator))
+			}
+
+			total := sdk.ZeroInt()
+			for validator, amount := range tt.delegations {
+				delegation := icstypes.NewDelegation(address, valoper(validator), sdk.NewCoin("uatom", sdk.NewInt(amount)))
+				for _, redelegated := range tt.redelegated {
+					if redelegated == validator {
+						delegation.RedelegationEnd = ctx.BlockTime().Add(time.Hour).Unix()
+					}
+				}
+				k.SetDelegation(ctx, &zone, delegation)
+				total = total.AddRaw(amount)
+			}
+			zone.DelegationAddresses = []*icstypes.ICAAccount{{Address: address, PortName: portID, DelegatedBalance: sdk.NewCoin("uatom", total)}}
+			k.SetRegisteredZone(ctx, zone)
+
+			s.Require().NoError(k.Rebalance(ctx, zone))
+
+			submitted := []*stakingtypes.MsgBeginRedelegate{}
+			for _, operation := range k.AllIcaOperations(ctx, zone.ChainId) {
+				msgs, err := icatypes.DeserializeCosmosTx(app.AppCodec(), operation.Data)
+				s.Require().NoError(err)
+				for _, msg := range msgs {
+					redelegate, ok := msg.(*stakingtypes.MsgBeginRedelegate)
+					s.Require().True(ok)
+					s.Require().Equal(address, redelegate.DelegatorAddress)
+					redelegate.DelegatorAddress = ""
+					submitted = append(submitted, redelegate)
+				}
+			}
+
+			if tt.count > 0 {
+				s.Require().Len(submitted, tt.count)
+				return
+			}
+			s.Require().Equal(len(tt.expected), len(submitted))
+			for i := range tt.expected {
+				s.Require().Equal(tt.expected[i], submitted[i])
+			}
+		})
+	}
+}
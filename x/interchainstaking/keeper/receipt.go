@@ -6,12 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
-	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
-	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
@@ -21,6 +17,20 @@ const UNSET = "unset"
 
 func (k Keeper) HandleReceiptTransaction(ctx sdk.Context, txr *sdk.TxResponse, txn *tx.Tx, zone types.RegisteredZone) {
 	k.Logger(ctx).Info("Deposit receipt.", "ischeck", ctx.IsCheckTx(), "isrecheck", ctx.IsReCheckTx())
+	if zone.Sunsetting {
+		k.Logger(ctx).Error("zone is sunsetting; not accepting deposits", "zone", zone.ChainId, "hash", txr.TxHash)
+		return
+	}
+	if !zone.DepositsEnabled() {
+		// the receipt is not recorded, so the deposit is processed once deposits are resumed.
+		k.Logger(ctx).Error("zone deposits are paused; deferring deposit", "zone", zone.ChainId, "state", types.ZoneStateName(zone.State), "hash", txr.TxHash)
+		return
+	}
+	if zone.CircuitBreakerTripped {
+		// the receipt is not recorded, so the deposit is processed once the zone is resumed.
+		k.Logger(ctx).Error("zone circuit breaker is tripped; deferring deposit", "zone", zone.ChainId, "hash", txr.TxHash)
+		return
+	}
 	hash := txr.TxHash
 	memo := txn.Body.Memo
 
@@ -63,18 +73,34 @@ func (k Keeper) HandleReceiptTransaction(ctx sdk.Context, txr *sdk.TxResponse, t
 		return
 	}
 
+	if k.isForwardedIBCDeposit(ctx, zone, txn) {
+		// qAssets for deposits forwarded from Quicksilver are minted on acknowledgement of the forwarding transfer.
+		k.Logger(ctx).Info("Found forwarded IBC deposit. Ignoring.", "hash", hash)
+		// no qAssets are minted against the host transaction; they are recorded on the receipt of the IBC deposit.
+		receipt := k.NewReceipt(ctx, zone, senderAddress, hash, coins)
+		receipt.QassetAmount = sdk.Coins{}
+		k.SetReceipt(ctx, *receipt)
+		return
+	}
+
 	if err := zone.ValidateCoinsForZone(ctx, coins); err != nil {
-		// we expect this to trigger if the validatorset has changed recently (i.e. we haven't seen the validator before. That is okay, we'll catch it next round!)
-		k.Logger(ctx).Error("unable to validate coins. Ignoring.", "sender", senderAddress)
+		// return the deposit to the sender; this includes tokenized shares of validators not (yet) in the validator set.
+		k.Logger(ctx).Error("unable to validate coins. Refunding.", "sender", senderAddress, "err", err)
+		k.AddRefund(ctx, zone, senderAddress, hash, coins, err.Error())
 		return
 	}
 
 	var accAddress sdk.AccAddress = addressBytes
 
+	depositMemo := k.parseDepositMemo(ctx, zone, memo, senderAddress, hash)
+	if len(depositMemo.Recipient) > 0 {
+		accAddress = depositMemo.Recipient
+	}
+
 	k.Logger(ctx).Info("Found new deposit tx", "deposit_address", zone.DepositAddress.GetAddress(), "sender", senderAddress, "local", accAddress.String(), "chain id", zone.ChainId, "amount", coins, "hash", hash)
 	// create receipt
 
-	k.UpdateIntent(ctx, accAddress, zone, coins, memo)
+	k.UpdateIntent(ctx, accAddress, zone, coins, depositMemo.ToOrdinalIntents(coins, zone.BaseDenom))
 	if err := k.MintQAsset(ctx, accAddress, zone, coins); err != nil {
 		k.Logger(ctx).Error("unable to mint QAsset. Ignoring.", "sender", senderAddress, "zone", zone.ChainId, "err", err)
 		return
@@ -88,13 +114,61 @@ func (k Keeper) HandleReceiptTransaction(ctx sdk.Context, txr *sdk.TxResponse, t
 
 	if err := k.TransferToDelegate(ctx, zone, sendPlan, hash); err != nil {
 		k.Logger(ctx).Error("unable to transfer to delegate. Ignoring.", "sender", senderAddress, "zone", zone.ChainId, "err", err)
+		// no receipt is recorded for a deposit that was not transferred, so its delegation plans are never executed.
+		for _, plan := range k.GetDelegationPlansForHash(ctx, &zone, hash) {
+			if err := k.RemoveDelegationPlan(ctx, &zone, hash, plan); err != nil {
+				k.Logger(ctx).Error("unable to remove delegation plan", "hash", hash, "err", err)
+			}
+		}
 		return
 	}
 	receipt := k.NewReceipt(ctx, zone, senderAddress, hash, coins)
+	receipt.DelegationPlans = k.GetDelegationPlansForHash(ctx, &zone, hash)
 
 	k.SetReceipt(ctx, *receipt)
 }
 
+// parseDepositMemo decodes the memo of a deposit, emitting the referral tag if set. A memo that fails to parse is
+// ignored, and an event emitted with the parse error.
+func (k Keeper) parseDepositMemo(ctx sdk.Context, zone types.RegisteredZone, memo string, sender string, hash string) types.DepositMemo {
+	depositMemo, err := zone.ParseMemo(memo)
+	if err != nil {
+		k.Logger(ctx).Error("unable to parse deposit memo. Ignoring memo.", "sender", sender, "zone", zone.ChainId, "hash", hash, "err", err)
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+			sdk.NewEvent(
+				types.EventTypeMemoParseError,
+				sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+				sdk.NewAttribute(types.AttributeKeySourceAddress, sender),
+				sdk.NewAttribute(types.AttributeKeyTxHash, hash),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			),
+		})
+		return types.DepositMemo{}
+	}
+
+	if depositMemo.Referral != "" {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+			sdk.NewEvent(
+				types.EventTypeDepositReferral,
+				sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+				sdk.NewAttribute(types.AttributeKeySourceAddress, sender),
+				sdk.NewAttribute(types.AttributeKeyTxHash, hash),
+				sdk.NewAttribute(types.AttributeKeyReferral, depositMemo.Referral),
+			),
+		})
+	}
+
+	return depositMemo
+}
+
 func attributesToMap(attrs []abcitypes.EventAttribute) map[string]string {
 	out := make(map[string]string)
 	for _, attr := range attrs {
@@ -103,13 +177,23 @@ func attributesToMap(attrs []abcitypes.EventAttribute) map[string]string {
 	return out
 }
 
-func (k *Keeper) MintQAsset(ctx sdk.Context, sender sdk.AccAddress, zone types.RegisteredZone, inCoins sdk.Coins) error {
+// QAssetAmount returns the amount of qAssets minted for the deposited coins, at the zone's redemption rate.
+func QAssetAmount(zone types.RegisteredZone, inCoins sdk.Coins) sdk.Coins {
 	outCoins := sdk.Coins{}
 	for _, inCoin := range inCoins {
 		outAmount := inCoin.Amount.ToDec().Quo(zone.RedemptionRate).TruncateInt()
 		outCoin := sdk.NewCoin(zone.LocalDenom, outAmount)
 		outCoins = outCoins.Add(outCoin)
 	}
+	return outCoins
+}
+
+func (k *Keeper) MintQAsset(ctx sdk.Context, sender sdk.AccAddress, zone types.RegisteredZone, inCoins sdk.Coins) error {
+	if zone.CircuitBreakerTripped {
+		return fmt.Errorf("minting is paused for zone %s; redemption rate circuit breaker is tripped", zone.ChainId)
+	}
+
+	outCoins := QAssetAmount(zone, inCoins)
 	k.Logger(ctx).Info("Minting qAssets for receipt", "assets", outCoins)
 	err := k.BankKeeper.MintCoins(ctx, types.ModuleName, outCoins)
 	if err != nil {
@@ -141,6 +225,36 @@ func (k *Keeper) TransferToDelegate(ctx sdk.Context, zone types.RegisteredZone,
 
 //}
 
+// HandleUntransferredDeposits resubmits the transfer of deposits from the deposit account to the delegation accounts
+// for which delegation plans remain, but no transfer is in flight; that is, those whose transfer timed out or failed.
+// The delegation plans of a deposit are removed as its transfers are acknowledged.
+func (k *Keeper) HandleUntransferredDeposits(ctx sdk.Context, zone *types.RegisteredZone) error {
+	hashes := []string{}
+	plans := map[string]types.Allocations{}
+	k.IterateAllDelegationPlans(ctx, zone, func(plan types.DelegationPlan, key []byte) bool {
+		txhash, ok := delegationPlanTxhash(zone, plan, key)
+		if !ok {
+			return false
+		}
+		if _, found := plans[txhash]; !found {
+			hashes = append(hashes, txhash)
+		}
+		plans[txhash] = plans[txhash].Allocate(plan.DelegatorAddress, plan.Value)
+		return false
+	})
+
+	for _, txhash := range hashes {
+		if k.hasPendingIcaOperation(ctx, zone.ChainId, zone.DepositAddress.GetPortName(), txhash) {
+			continue
+		}
+		k.Logger(ctx).Info("resubmitting transfer of deposit to delegation accounts", "zone", zone.ChainId, "hash", txhash)
+		if err := k.TransferToDelegate(ctx, *zone, plans[txhash], txhash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // func (k *Keeper) TransferToDelegateMulti(ctx sdk.Context, zone types.RegisteredZone, plan types.SendPlan, memo string) error {
 // 	eachAmount := sdk.Coins{}
 // 	splits := utils.MinU64(append([]uint64{}, k.GetParam(ctx, types.KeyDelegateAccountCount), uint64(len(zone.GetDelegationAccounts()))))
@@ -178,41 +292,24 @@ func (k *Keeper) TransferToDelegate(ctx sdk.Context, zone types.RegisteredZone,
 // 	return k.SubmitTx(ctx, []sdk.Msg{msg}, zone.DepositAddress, memo)
 // }
 
+// SubmitTx submits the messages to the host chain as interchain account transactions of the given account. Messages
+// are split into ICA operations of at most MaxMsgsPerTx messages, each submitted as a separate packet and tracked by
+// its sequence until it is acknowledged or times out.
 func (k *Keeper) SubmitTx(ctx sdk.Context, msgs []sdk.Msg, account *types.ICAAccount, memo string) error {
-	portID := account.GetPortName()
-	connectionID, err := k.GetConnectionForPort(ctx, portID)
-	if err != nil {
-		return err
-	}
-
-	channelID, found := k.ICAControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
-	if !found {
-		return sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", portID)
+	if account.Unavailable {
+		return fmt.Errorf("interchain account %s is unavailable until its channel is re-opened", account.Address)
 	}
 
-	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
-	if !found {
-		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
-	}
-
-	data, err := icatypes.SerializeCosmosTx(k.cdc, msgs)
+	portID := account.GetPortName()
+	connectionID, err := k.GetConnectionForPort(ctx, portID)
 	if err != nil {
 		return err
 	}
 
-	// validate memo < 256 bytes
-	packetData := icatypes.InterchainAccountPacketData{
-		Type: icatypes.EXECUTE_TX,
-		Data: data,
-		Memo: memo,
-	}
-
-	// timeoutTimestamp set to max value with the unsigned bit shifted to sastisfy hermes timestamp conversion
-	// it is the responsibility of the auth module developer to ensure an appropriate timeout timestamp
-	timeoutTimestamp := ^uint64(0) >> 1
-	_, err = k.ICAControllerKeeper.SendTx(ctx, chanCap, connectionID, portID, packetData, timeoutTimestamp)
-	if err != nil {
-		return err
+	for _, operationMsgs := range types.SplitIcaOperations(msgs, int(k.GetMaxMsgsPerTx(ctx))) {
+		if err := k.sendIcaOperation(ctx, connectionID, portID, operationMsgs, memo, 1); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -221,7 +318,7 @@ func (k *Keeper) SubmitTx(ctx sdk.Context, msgs []sdk.Msg, account *types.ICAAcc
 // ---------------------------------------------------------------
 
 func (k Keeper) NewReceipt(ctx sdk.Context, zone types.RegisteredZone, sender string, txhash string, amount sdk.Coins) *types.Receipt {
-	return &types.Receipt{Zone: &zone, Sender: sender, Txhash: txhash, Amount: amount}
+	return &types.Receipt{Zone: &zone, Sender: sender, Txhash: txhash, Amount: amount, QassetAmount: QAssetAmount(zone, amount)}
 }
 
 // GetReceipt returns receipt
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestReceiptsQuery() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	sender := "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv"
+	other := "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a"
+
+	zone := icstypes.RegisteredZone{
+		ChainId:        s.chainB.ChainID,
+		ConnectionId:   s.path.EndpointA.ConnectionID,
+		LocalDenom:     "uqatom",
+		BaseDenom:      "uatom",
+		RedemptionRate: sdk.NewDecWithPrec(125, 2),
+	}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	amount := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)))
+	app.InterchainstakingKeeper.SetReceipt(ctx, *app.InterchainstakingKeeper.NewReceipt(ctx, zone, sender, "hash1", amount))
+	app.InterchainstakingKeeper.SetReceipt(ctx, *app.InterchainstakingKeeper.NewReceipt(ctx, zone, other, "hash2", amount))
+
+	res, err := app.InterchainstakingKeeper.Receipts(sdk.WrapSDKContext(ctx), &icstypes.QueryReceiptsRequest{ChainId: zone.ChainId})
+	s.Require().NoError(err)
+	s.Require().Len(res.Receipts, 2)
+
+	res, err = app.InterchainstakingKeeper.Receipts(sdk.WrapSDKContext(ctx), &icstypes.QueryReceiptsRequest{ChainId: zone.ChainId, Sender: sender})
+	s.Require().NoError(err)
+	s.Require().Len(res.Receipts, 1)
+	s.Require().Equal("hash1", res.Receipts[0].Txhash)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(800))), res.Receipts[0].QassetAmount)
+
+	single, err := app.InterchainstakingKeeper.Receipt(sdk.WrapSDKContext(ctx), &icstypes.QueryReceiptRequest{ChainId: zone.ChainId, Txhash: "hash2"})
+	s.Require().NoError(err)
+	s.Require().Equal(other, single.Receipt.Sender)
+
+	_, err = app.InterchainstakingKeeper.Receipt(sdk.WrapSDKContext(ctx), &icstypes.QueryReceiptRequest{ChainId: zone.ChainId, Txhash: "missing"})
+	s.Require().Error(err)
+
+	_, err = app.InterchainstakingKeeper.Receipts(sdk.WrapSDKContext(ctx), &icstypes.QueryReceiptsRequest{ChainId: "unknown-1"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestForwardedIBCDepositReceipt() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	depositAddress := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	sender := "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv"
+	receiver := sdk.AccAddress([]byte("receiver____________"))
+
+	zone := icstypes.RegisteredZone{
+		ChainId:        s.chainB.ChainID,
+		ConnectionId:   s.path.EndpointA.ConnectionID,
+		LocalDenom:     "uqatom",
+		BaseDenom:      "uatom",
+		RedemptionRate: sdk.OneDec(),
+		DepositAddress: &icstypes.ICAAccount{Address: depositAddress},
+	}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+	amount := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)))
+	app.InterchainstakingKeeper.SetIBCDeposit(ctx, icstypes.IBCDeposit{ChainId: zone.ChainId, Receiver: receiver.String(), Amount: amount, ChannelId: "channel-0", Sequence: 1})
+
+	recv, err := codectypes.NewAnyWithValue(&channeltypes.MsgRecvPacket{Packet: channeltypes.Packet{SourcePort: transfertypes.PortID, SourceChannel: "channel-0", Sequence: 1}})
+	s.Require().NoError(err)
+	txr := &sdk.TxResponse{
+		TxHash: "hash",
+		Events: []abcitypes.Event{{
+			Type: "transfer",
+			Attributes: []abcitypes.EventAttribute{
+				{Key: []byte("sender"), Value: []byte(sender)},
+				{Key: []byte("recipient"), Value: []byte(depositAddress)},
+				{Key: []byte("amount"), Value: []byte("1000uatom")},
+			},
+		}},
+	}
+	app.InterchainstakingKeeper.HandleReceiptTransaction(ctx, txr, &tx.Tx{Body: &tx.TxBody{Messages: []*codectypes.Any{recv}}}, zone)
+
+	// the host transaction of a forwarded deposit is recorded, but mints nothing.
+	receipt, found := app.InterchainstakingKeeper.GetReceipt(ctx, icskeeper.GetReceiptKey(zone, "hash"))
+	s.Require().True(found)
+	s.Require().Equal(amount, receipt.Amount)
+	s.Require().True(receipt.QassetAmount.IsZero())
+	s.Require().True(app.BankKeeper.GetSupply(ctx, "uqatom").IsZero())
+}
+
+func (s *KeeperTestSuite) TestUntransferredDepositPlans() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	depositAddress := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	delegator := "cosmos1rw9fsfcafx0gjpw7hvxcduc0qv026r4q20mk6uwhe3janx7qs9rqz0dqrp"
+	sender := "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv"
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+
+	// the deposit account has no open channel, so the deposit cannot be transferred to the delegation accounts.
+	zone := icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		AccountPrefix:       "cosmos",
+		RedemptionRate:      sdk.OneDec(),
+		DepositAddress:      &icstypes.ICAAccount{Address: depositAddress, PortName: s.chainB.ChainID + ".deposit"},
+		DelegationAddresses: []*icstypes.ICAAccount{{Address: delegator, PortName: s.chainB.ChainID + ".delegate.0", DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())}},
+		Validators:          []*icstypes.Validator{{ValoperAddress: validator, CommissionRate: sdk.ZeroDec(), VotingPower: sdk.NewInt(1000), Status: stakingtypes.BondStatusBonded}},
+	}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	txr := &sdk.TxResponse{
+		TxHash: "hash",
+		Events: []abcitypes.Event{{
+			Type: "transfer",
+			Attributes: []abcitypes.EventAttribute{
+				{Key: []byte("sender"), Value: []byte(sender)},
+				{Key: []byte("recipient"), Value: []byte(depositAddress)},
+				{Key: []byte("amount"), Value: []byte("1000uatom")},
+			},
+		}},
+	}
+	app.InterchainstakingKeeper.HandleReceiptTransaction(ctx, txr, &tx.Tx{Body: &tx.TxBody{}}, zone)
+
+	_, found := app.InterchainstakingKeeper.GetReceipt(ctx, icskeeper.GetReceiptKey(zone, "hash"))
+	s.Require().False(found)
+	s.Require().Empty(app.InterchainstakingKeeper.GetDelegationPlansForHash(ctx, &zone, "hash"))
+	_, broken := icskeeper.DelegationPlansInvariant(app.InterchainstakingKeeper)(ctx)
+	s.Require().False(broken)
+}
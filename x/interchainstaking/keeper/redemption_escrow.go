@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func getRedemptionEscrowsKey(chainID string) []byte {
+	return append(types.KeyPrefixRedemptionEscrow, []byte(chainID+"/")...)
+}
+
+// GetRedemptionEscrow returns the escrow of the redemption with the given hash.
+func (k Keeper) GetRedemptionEscrow(ctx sdk.Context, chainID string, txhash string) (types.RedemptionEscrow, bool) {
+	escrow := types.RedemptionEscrow{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getRedemptionEscrowsKey(chainID))
+	bz := store.Get([]byte(txhash))
+	if len(bz) == 0 {
+		return escrow, false
+	}
+	k.cdc.MustUnmarshal(bz, &escrow)
+	return escrow, true
+}
+
+// SetRedemptionEscrow stores the redemption escrow.
+func (k Keeper) SetRedemptionEscrow(ctx sdk.Context, escrow types.RedemptionEscrow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getRedemptionEscrowsKey(escrow.ChainId))
+	store.Set([]byte(escrow.Txhash), k.cdc.MustMarshal(&escrow))
+}
+
+// DeleteRedemptionEscrow deletes the escrow of the redemption with the given hash.
+func (k Keeper) DeleteRedemptionEscrow(ctx sdk.Context, chainID string, txhash string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getRedemptionEscrowsKey(chainID))
+	store.Delete([]byte(txhash))
+}
+
+// IterateRedemptionEscrows iterates through the redemption escrows of the given zone.
+func (k Keeper) IterateRedemptionEscrows(ctx sdk.Context, chainID string, fn func(index int64, escrow types.RedemptionEscrow) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getRedemptionEscrowsKey(chainID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		escrow := types.RedemptionEscrow{}
+		k.cdc.MustUnmarshal(iterator.Value(), &escrow)
+		if fn(i, escrow) {
+			break
+		}
+		i++
+	}
+}
+
+// AllRedemptionEscrows returns every redemption escrow of the given zone.
+func (k Keeper) AllRedemptionEscrows(ctx sdk.Context, chainID string) []types.RedemptionEscrow {
+	escrows := []types.RedemptionEscrow{}
+	k.IterateRedemptionEscrows(ctx, chainID, func(_ int64, escrow types.RedemptionEscrow) bool {
+		escrows = append(escrows, escrow)
+		return false
+	})
+	return escrows
+}
+
+// AllWithdrawalRecordsForRedemption returns the withdrawal records of the redemption with the given hash, across all
+// of the zone's delegation accounts.
+func (k Keeper) AllWithdrawalRecordsForRedemption(ctx sdk.Context, zone *types.RegisteredZone, txhash string) []types.WithdrawalRecord {
+	records := []types.WithdrawalRecord{}
+	for _, da := range zone.GetDelegationAccounts() {
+		records = append(records, k.AllWithdrawalRecordsWithHash(ctx, txhash, da.Address)...)
+	}
+	return records
+}
+
+// SettleRedemptionEscrow settles the escrow of the redemption with the given hash against its withdrawal records. The
+// share of the escrow backing failed withdrawals is refunded to the redeemer; once every withdrawal has completed or
+// failed, the share backing completed withdrawals is burned and the escrow is removed.
+func (k Keeper) SettleRedemptionEscrow(ctx sdk.Context, zone *types.RegisteredZone, txhash string) error {
+	escrow, found := k.GetRedemptionEscrow(ctx, zone.ChainId, txhash)
+	if !found {
+		k.Logger(ctx).Error("no escrow found for redemption", "zone", zone.ChainId, "hash", txhash)
+		return nil
+	}
+
+	total := sdk.ZeroInt()
+	completed := sdk.ZeroInt()
+	failed := sdk.ZeroInt()
+	pending := false
+	for _, record := range k.AllWithdrawalRecordsForRedemption(ctx, zone, txhash) {
+		total = total.Add(record.Amount.Amount)
+		switch record.Status {
+		case types.WithdrawStatusCompleted:
+			completed = completed.Add(record.Amount.Amount)
+		case types.WithdrawStatusFailed:
+			failed = failed.Add(record.Amount.Amount)
+		default:
+			pending = true
+		}
+	}
+	if total.IsZero() {
+		return nil
+	}
+
+	var burn sdk.Int
+	var refund sdk.Int
+	if pending {
+		burn = sdk.ZeroInt()
+		refund = escrow.Amount.Amount.Mul(failed).Quo(total).Sub(escrow.Refunded.Amount)
+	} else {
+		// the remainder of the escrow, after burning the share of completed withdrawals, is refunded.
+		burn = escrow.Amount.Amount.Mul(completed).Quo(total)
+		refund = escrow.Amount.Amount.Sub(burn).Sub(escrow.Refunded.Amount)
+	}
+
+	if burn.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(escrow.Amount.Denom, burn))
+		if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("burned escrowed coins post-withdrawal", "hash", txhash, "coins", coins)
+	}
+
+	if refund.IsPositive() {
+		redeemer, err := sdk.AccAddressFromBech32(escrow.Redeemer)
+		if err != nil {
+			return err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(escrow.Amount.Denom, refund))
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, coins); err != nil {
+			return err
+		}
+		escrow.Refunded = escrow.Refunded.AddAmount(refund)
+		k.Logger(ctx).Info("refunded escrowed coins for failed withdrawal", "hash", txhash, "redeemer", escrow.Redeemer, "coins", coins)
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+			sdk.NewEvent(
+				types.EventTypeRedemptionRefund,
+				sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+				sdk.NewAttribute(types.AttributeKeyTxHash, txhash),
+				sdk.NewAttribute(types.AttributeKeyRecipientAddress, escrow.Redeemer),
+				sdk.NewAttribute(types.AttributeKeyRefundAmount, coins.String()),
+			),
+		})
+	}
+
+	if pending {
+		k.SetRedemptionEscrow(ctx, escrow)
+	} else {
+		k.DeleteRedemptionEscrow(ctx, zone.ChainId, txhash)
+	}
+	return nil
+}
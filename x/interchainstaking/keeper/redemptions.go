@@ -0,0 +1,288 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// processRedemptionForLsm tokenizes the target delegations directly to the recipient; used for zones that
+// support the liquid staking module.
+func (k *Keeper) processRedemptionForLsm(ctx sdk.Context, zone types.RegisteredZone, targets RedemptionTargets, recipient string, burnAmount sdk.Coin, hash string) (sdk.Coins, error) {
+	sumAmount := sdk.NewCoins()
+
+	msgs := make(map[string][]sdk.Msg, 0)
+
+	for _, target := range targets.Sorted() {
+		if len(target.Value) == 1 {
+			if _, ok := msgs[target.DelegatorAddress]; !ok {
+				msgs[target.DelegatorAddress] = make([]sdk.Msg, 0)
+			}
+			msgs[target.DelegatorAddress] = append(msgs[target.DelegatorAddress], &stakingtypes.MsgTokenizeShares{
+				DelegatorAddress:    target.DelegatorAddress,
+				ValidatorAddress:    target.ValidatorAddress,
+				Amount:              target.Value[0],
+				TokenizedShareOwner: recipient,
+			})
+			sumAmount = sumAmount.Add(target.Value[0])
+			k.AddWithdrawalRecord(ctx, target.DelegatorAddress, target.ValidatorAddress, recipient, target.Value[0], burnAmount, hash, types.WithdrawStatusTokenize)
+		}
+	}
+
+	delegators := make([]string, 0, len(msgs))
+	for delegator := range msgs {
+		delegators = append(delegators, delegator)
+	}
+	sort.Strings(delegators)
+
+	for _, delegator := range delegators {
+		icaAccount, err := zone.GetDelegationAccountByAddress(delegator)
+		if err != nil {
+			panic(err) // panic here because something is terribly wrong if we cann't find the delegation bucket here!!!
+		}
+		err = k.SubmitTx(ctx, msgs[delegator], icaAccount, hash)
+		if err != nil {
+			k.Logger(ctx).Error("error submitting tx", "err", err)
+			return nil, err
+		}
+	}
+
+	return sumAmount, nil
+}
+
+// queueRedemption records the redemption targets as queued withdrawals; for zones without the liquid staking
+// module, queued withdrawals are unbonded in a single batch at the end of the epoch (see HandleQueuedUnbondings).
+func (k *Keeper) queueRedemption(ctx sdk.Context, targets RedemptionTargets, recipient string, burnAmount sdk.Coin, hash string) sdk.Coins {
+	sumAmount := sdk.NewCoins()
+
+	for _, target := range targets.Sorted() {
+		if len(target.Value) == 1 {
+			sumAmount = sumAmount.Add(target.Value[0])
+			k.AddWithdrawalRecord(ctx, target.DelegatorAddress, target.ValidatorAddress, recipient, target.Value[0], burnAmount, hash, types.WithdrawStatusQueued)
+		}
+	}
+
+	return sumAmount
+}
+
+// HandleQueuedUnbondings aggregates queued withdrawal records per delegation account and validator, and submits
+// a single MsgUndelegate for each tuple. Records are moved to types.WithdrawStatusUnbond once the tx has been submitted,
+// and record the memo of the tx as their unbonding batch.
+func (k *Keeper) HandleQueuedUnbondings(ctx sdk.Context, zone *types.RegisteredZone, epoch int64) error {
+	for _, da := range zone.GetDelegationAccounts() {
+		queued := []types.WithdrawalRecord{}
+		k.IterateWithdrawalRecords(ctx, da.Address, func(_ int64, record types.WithdrawalRecord) bool {
+			if record.Status == types.WithdrawStatusQueued {
+				queued = append(queued, record)
+			}
+			return false
+		})
+
+		if len(queued) == 0 {
+			continue
+		}
+
+		allocations := types.Allocations{}
+		for _, record := range queued {
+			allocations = allocations.Allocate(record.Validator, sdk.NewCoins(record.Amount))
+		}
+
+		msgs := make([]sdk.Msg, 0, len(allocations))
+		for _, allocation := range allocations.Sorted() {
+			for _, amount := range allocation.Amount {
+				msgs = append(msgs, &stakingtypes.MsgUndelegate{
+					DelegatorAddress: da.Address,
+					ValidatorAddress: allocation.Address,
+					Amount:           amount,
+				})
+			}
+		}
+
+		batch := fmt.Sprintf("unbond/%d", epoch)
+		if err := k.SubmitTx(ctx, msgs, da, batch); err != nil {
+			k.Logger(ctx).Error("error submitting unbonding tx", "delegator", da.Address, "err", err)
+			return err
+		}
+
+		for _, record := range queued {
+			record := record
+			record.Status = types.WithdrawStatusUnbond
+			record.UnbondingBatch = batch
+			k.SetWithdrawalRecord(ctx, &record)
+		}
+	}
+
+	return nil
+}
+
+// HandleUndelegate sets the completion time on unbonding withdrawal records of the acknowledged batch, for the
+// delegator / validator tuple of the MsgUndelegate.
+func (k *Keeper) HandleUndelegate(ctx sdk.Context, msg sdk.Msg, completion time.Time, memo string) error {
+	k.Logger(ctx).Info("Received MsgUndelegate acknowledgement")
+	// first, type assertion. we should have stakingtypes.MsgUndelegate
+	undelegateMsg, ok := msg.(*stakingtypes.MsgUndelegate)
+	if !ok {
+		k.Logger(ctx).Error("unable to cast source message to MsgUndelegate")
+		return fmt.Errorf("unable to cast source message to MsgUndelegate")
+	}
+
+	// NOTE: setting records mid-iteration breaks the iterator; cache the results and update retrospectively.
+	updated := []types.WithdrawalRecord{}
+	k.IterateWithdrawalRecords(ctx, undelegateMsg.DelegatorAddress, func(_ int64, record types.WithdrawalRecord) bool {
+		if isUnbondingInBatch(record, undelegateMsg, memo) {
+			record.CompletionTime = completion
+			updated = append(updated, record)
+		}
+		return false
+	})
+
+	for _, record := range updated {
+		record := record
+		k.SetWithdrawalRecord(ctx, &record)
+	}
+
+	// redemptions from a sunsetting zone are available once the last of its delegations has unbonded. The unbonded
+	// amount is removed from the delegation records, so that it is not unbonded again by HandleSunsetEpoch.
+	if zone := k.GetZoneForDelegateAccount(ctx, undelegateMsg.DelegatorAddress); zone != nil && zone.Sunsetting && memo == SunsetMemo {
+		k.removeUnbondedDelegation(ctx, zone, undelegateMsg)
+		if completion.After(zone.SunsetCompletion) {
+			zone.SunsetCompletion = completion
+		}
+		k.SetRegisteredZone(ctx, *zone)
+	}
+
+	k.Logger(ctx).Info("Unbonding initiated", "delegator", undelegateMsg.DelegatorAddress, "validator", undelegateMsg.ValidatorAddress, "completion", completion, "records", len(updated))
+	return nil
+}
+
+// removeUnbondedDelegation deducts the amount of an acknowledged MsgUndelegate from the delegation record and the
+// delegated balance of the delegation account. The caller is responsible for storing the zone.
+func (k *Keeper) removeUnbondedDelegation(ctx sdk.Context, zone *types.RegisteredZone, msg *stakingtypes.MsgUndelegate) {
+	delegation, found := k.GetDelegation(ctx, zone, msg.DelegatorAddress, msg.ValidatorAddress)
+	if !found {
+		return
+	}
+	amount := sdk.MinInt(delegation.Amount.Amount, msg.Amount.Amount)
+	if da, err := zone.GetDelegationAccountByAddress(msg.DelegatorAddress); err == nil && !da.DelegatedBalance.Amount.IsNil() {
+		da.DelegatedBalance = da.DelegatedBalance.SubAmount(sdk.MinInt(da.DelegatedBalance.Amount, amount))
+	}
+	delegation.Amount = delegation.Amount.SubAmount(amount)
+	if delegation.Amount.IsZero() {
+		if err := k.RemoveDelegation(ctx, zone, delegation); err != nil {
+			k.Logger(ctx).Error("unable to remove unbonded delegation", "delegator", msg.DelegatorAddress, "validator", msg.ValidatorAddress, "err", err)
+		}
+		return
+	}
+	k.SetDelegation(ctx, zone, delegation)
+}
+
+// isUnbondingInBatch returns true if the withdrawal record is awaiting the acknowledgement of the MsgUndelegate,
+// submitted in the batch with the given memo.
+func isUnbondingInBatch(record types.WithdrawalRecord, msg *stakingtypes.MsgUndelegate, memo string) bool {
+	return record.Status == types.WithdrawStatusUnbond && record.UnbondingBatch == memo && record.Validator == msg.ValidatorAddress && record.CompletionTime.IsZero()
+}
+
+// PayoutUnbondedWithdrawals sends the unbonded tokens of matured withdrawal records to their recipients. One
+// MsgSend is sent per record, with the redemption hash as memo, so that acknowledgements can be matched in
+// handleWithdrawForUser.
+func (k *Keeper) PayoutUnbondedWithdrawals(ctx sdk.Context, zone *types.RegisteredZone) error {
+	for _, da := range zone.GetDelegationAccounts() {
+		matured := map[string][]types.WithdrawalRecord{}
+		k.IterateWithdrawalRecords(ctx, da.Address, func(_ int64, record types.WithdrawalRecord) bool {
+			if record.Status == types.WithdrawStatusUnbond && !record.CompletionTime.IsZero() && !record.CompletionTime.After(ctx.BlockTime()) {
+				matured[record.Txhash] = append(matured[record.Txhash], record)
+			}
+			return false
+		})
+
+		hashes := make([]string, 0, len(matured))
+		for hash := range matured {
+			hashes = append(hashes, hash)
+		}
+		sort.Strings(hashes)
+
+		for _, hash := range hashes {
+			msgs := make([]sdk.Msg, 0, len(matured[hash]))
+			for _, record := range matured[hash] {
+				msgs = append(msgs, &banktypes.MsgSend{FromAddress: da.Address, ToAddress: record.Recipient, Amount: sdk.NewCoins(record.Amount)})
+			}
+
+			if err := k.SubmitTx(ctx, msgs, da, hash); err != nil {
+				k.Logger(ctx).Error("error submitting withdrawal payout tx", "delegator", da.Address, "hash", hash, "err", err)
+				return err
+			}
+
+			for _, record := range matured[hash] {
+				record := record
+				record.Status = types.WithdrawStatusSend
+				k.SetWithdrawalRecord(ctx, &record)
+			}
+		}
+	}
+
+	return nil
+}
+
+// failWithdrawalRecords marks the withdrawal records processed by the messages of a failed interchain account
+// operation as failed, and refunds the escrowed qAssets backing them. Returns true if any record was failed.
+func (k *Keeper) failWithdrawalRecords(ctx sdk.Context, zone *types.RegisteredZone, msgs []sdk.Msg, memo string) (bool, error) {
+	// NOTE: setting records mid-iteration breaks the iterator; cache the results and update retrospectively.
+	failed := []types.WithdrawalRecord{}
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgTokenizeShares:
+			k.IterateWithdrawalRecordsWithTxhash(ctx, memo, msg.DelegatorAddress, func(_ int64, record types.WithdrawalRecord) bool {
+				if record.Status == types.WithdrawStatusTokenize && record.Validator == msg.ValidatorAddress && record.Amount.IsEqual(msg.Amount) {
+					failed = append(failed, record)
+				}
+				return false
+			})
+		case *banktypes.MsgSend:
+			k.IterateWithdrawalRecordsWithTxhash(ctx, memo, msg.FromAddress, func(_ int64, record types.WithdrawalRecord) bool {
+				if record.Status == types.WithdrawStatusSend && record.Recipient == msg.ToAddress && len(msg.Amount) == 1 && record.Amount.Amount.Equal(msg.Amount[0].Amount) {
+					failed = append(failed, record)
+				}
+				return false
+			})
+		}
+	}
+
+	requeried := map[string]bool{}
+	hashes := map[string]struct{}{}
+	for _, record := range failed {
+		record := record
+		k.Logger(ctx).Error("withdrawal failed", "delegator", record.Delegator, "validator", record.Validator, "recipient", record.Recipient, "amount", record.Amount, "hash", record.Txhash)
+		unbonded := record.Status == types.WithdrawStatusSend
+		record.Status = types.WithdrawStatusFailed
+		k.SetWithdrawalRecord(ctx, &record)
+		hashes[record.Txhash] = struct{}{}
+
+		// the unbonded tokens of a failed payout remain in the delegation account, and are delegated again.
+		if unbonded && !requeried[record.Delegator] {
+			if err := k.requeryAllBalances(ctx, zone, record.Delegator); err != nil {
+				return false, err
+			}
+			requeried[record.Delegator] = true
+		}
+	}
+
+	// settle the escrow of each affected redemption, in a deterministic order.
+	sortedHashes := make([]string, 0, len(hashes))
+	for hash := range hashes {
+		sortedHashes = append(sortedHashes, hash)
+	}
+	sort.Strings(sortedHashes)
+	for _, hash := range sortedHashes {
+		if err := k.SettleRedemptionEscrow(ctx, zone, hash); err != nil {
+			return false, err
+		}
+	}
+
+	return len(failed) > 0, nil
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestQueuedRedemption() {
+	portID, delegator := s.openICAChannel(s.chainB.ChainID + ".delegate.0")
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	k := app.InterchainstakingKeeper
+
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	recipient := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	user := sdk.AccAddress([]byte("user________________"))
+
+	zone := icstypes.RegisteredZone{
+		ChainId:            s.chainB.ChainID,
+		ConnectionId:       s.path.EndpointA.ConnectionID,
+		AccountPrefix:      "cosmos",
+		LocalDenom:         "uqatom",
+		BaseDenom:          "uatom",
+		RedemptionRate:     sdk.OneDec(),
+		LastRedemptionRate: sdk.OneDec(),
+		DelegationAddresses: []*icstypes.ICAAccount{
+			{Address: delegator, PortName: portID, Balance: sdk.Coins{}, DelegatedBalance: sdk.NewCoin("uatom", sdk.NewInt(1000))},
+		},
+		Validators: []*icstypes.Validator{{ValoperAddress: validator, CommissionRate: sdk.NewDecWithPrec(5, 2), VotingPower: sdk.NewInt(1000), DelegatorShares: sdk.NewDec(1000), Score: sdk.ZeroDec()}},
+	}
+	k.SetRegisteredZone(ctx, zone)
+	k.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegator, validator, sdk.NewCoin("uatom", sdk.NewInt(1000))))
+
+	qAssets := sdk.NewCoin("uqatom", sdk.NewInt(400))
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(qAssets)))
+	s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, icstypes.ModuleName, user, sdk.NewCoins(qAssets)))
+
+	// the redemption of a zone without the liquidity module is queued, with its qAssets escrowed.
+	msgSrv := icskeeper.NewMsgServerImpl(k)
+	_, err := msgSrv.RequestRedemption(sdk.WrapSDKContext(ctx), &icstypes.MsgRequestRedemption{Coin: qAssets.String(), DestinationAddress: recipient, FromAddress: user.String()})
+	s.Require().NoError(err)
+
+	records := k.AllWithdrawalRecords(ctx, delegator)
+	s.Require().Len(records, 1)
+	record := records[0]
+	s.Require().Equal(icstypes.WithdrawStatusQueued, record.Status)
+	s.Require().Equal(validator, record.Validator)
+	s.Require().Equal(sdk.NewCoin("uatom", sdk.NewInt(400)), record.Amount)
+	escrow, found := k.GetRedemptionEscrow(ctx, zone.ChainId, record.Txhash)
+	s.Require().True(found)
+	s.Require().Equal(qAssets, escrow.Amount)
+	s.Require().True(app.BankKeeper.GetBalance(ctx, user, "uqatom").IsZero())
+
+	// queued withdrawals are unbonded in a single batch.
+	s.Require().NoError(k.HandleQueuedUnbondings(ctx, &zone, 1))
+	record, _ = k.GetWithdrawalRecord(ctx, record.Txhash, delegator, validator, recipient)
+	s.Require().Equal(icstypes.WithdrawStatusUnbond, record.Status)
+	s.Require().Equal("unbond/1", record.UnbondingBatch)
+	operations := k.AllIcaOperations(ctx, zone.ChainId)
+	s.Require().Len(operations, 1)
+	s.Require().Equal([]string{sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{})}, operations[0].MsgTypes)
+	s.Require().Equal(record.UnbondingBatch, operations[0].Memo)
+
+	completion := ctx.BlockTime().Add(time.Hour)
+	undelegate := &stakingtypes.MsgUndelegate{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: record.Amount}
+	s.Require().NoError(k.HandleUndelegate(ctx, undelegate, completion, record.UnbondingBatch))
+
+	// immature withdrawals are not paid out.
+	s.Require().NoError(k.PayoutUnbondedWithdrawals(ctx, &zone))
+	record, _ = k.GetWithdrawalRecord(ctx, record.Txhash, delegator, validator, recipient)
+	s.Require().Equal(icstypes.WithdrawStatusUnbond, record.Status)
+
+	// matured withdrawals are paid out to the recipient, with the redemption hash as memo.
+	ctx = ctx.WithBlockTime(completion)
+	s.Require().NoError(k.PayoutUnbondedWithdrawals(ctx, &zone))
+	record, _ = k.GetWithdrawalRecord(ctx, record.Txhash, delegator, validator, recipient)
+	s.Require().Equal(icstypes.WithdrawStatusSend, record.Status)
+	operations = k.AllIcaOperations(ctx, zone.ChainId)
+	s.Require().Len(operations, 2)
+	payout := operations[1]
+	s.Require().Equal([]string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, payout.MsgTypes)
+	s.Require().Equal(record.Txhash, payout.Memo)
+
+	// the acknowledged payout completes the withdrawal, and burns the escrow.
+	send := &banktypes.MsgSend{FromAddress: delegator, ToAddress: recipient, Amount: sdk.NewCoins(record.Amount)}
+	s.Require().NoError(k.HandleCompleteSend(ctx, send, record.Txhash))
+	record, _ = k.GetWithdrawalRecord(ctx, record.Txhash, delegator, validator, recipient)
+	s.Require().Equal(icstypes.WithdrawStatusCompleted, record.Status)
+	_, found = k.GetRedemptionEscrow(ctx, zone.ChainId, record.Txhash)
+	s.Require().False(found)
+	s.Require().True(app.BankKeeper.GetSupply(ctx, "uqatom").IsZero())
+}
+
+func (s *KeeperTestSuite) TestQueuedUnbondingWithLiquidityModule() {
+	portID, delegator := s.openICAChannel(s.chainB.ChainID + ".delegate.0")
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	k := app.InterchainstakingKeeper
+
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	recipient := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+	zone := icstypes.RegisteredZone{
+		ChainId:            s.chainB.ChainID,
+		ConnectionId:       s.path.EndpointA.ConnectionID,
+		AccountPrefix:      "cosmos",
+		LocalDenom:         "uqatom",
+		BaseDenom:          "uatom",
+		RedemptionRate:     sdk.OneDec(),
+		LastRedemptionRate: sdk.OneDec(),
+		LiquidityModule:    true,
+		DelegationAddresses: []*icstypes.ICAAccount{
+			{Address: delegator, PortName: portID, Balance: sdk.Coins{}, DelegatedBalance: sdk.NewCoin("uatom", sdk.NewInt(1000))},
+		},
+		Validators: []*icstypes.Validator{{ValoperAddress: validator, CommissionRate: sdk.NewDecWithPrec(5, 2), VotingPower: sdk.NewInt(1000), DelegatorShares: sdk.NewDec(1000), Score: sdk.ZeroDec()}},
+	}
+	k.SetRegisteredZone(ctx, zone)
+	k.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegator, validator, sdk.NewCoin("uatom", sdk.NewInt(1000))))
+
+	// a withdrawal queued before the liquidity module was enabled is still unbonded at the end of the epoch.
+	k.AddWithdrawalRecord(ctx, delegator, validator, recipient, sdk.NewCoin("uatom", sdk.NewInt(400)), sdk.NewCoin("uqatom", sdk.NewInt(400)), "redemption", icstypes.WithdrawStatusQueued)
+	k.HandleEpochForZone(ctx, zone, 2)
+
+	record, found := k.GetWithdrawalRecord(ctx, "redemption", delegator, validator, recipient)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.WithdrawStatusUnbond, record.Status)
+	s.Require().Equal("unbond/2", record.UnbondingBatch)
+}
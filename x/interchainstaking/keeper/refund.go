@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// RefundMemo prefixes the memo of interchain transactions returning rejected deposits.
+const RefundMemo = "refund"
+
+// isRefundMemo returns true if the memo is that of a refund batch.
+func isRefundMemo(memo string) bool {
+	return memo == RefundMemo || strings.HasPrefix(memo, RefundMemo+"/")
+}
+
+// refundBatch returns the batch of the refunds sent in a transaction with the given memo. Refunds sent before they
+// were batched were sent with the bare refund memo, and have no batch.
+func refundBatch(memo string) string {
+	if memo == RefundMemo {
+		return ""
+	}
+	return memo
+}
+
+func getRefundsKey(chainID string) []byte {
+	return append(types.KeyPrefixRefund, []byte(chainID+"/")...)
+}
+
+// AddRefund records a rejected deposit, to be returned to the sender on the next deposit interval.
+func (k Keeper) AddRefund(ctx sdk.Context, zone types.RegisteredZone, recipient string, txhash string, amount sdk.Coins, reason string) {
+	refund := types.Refund{ChainId: zone.ChainId, Txhash: txhash, Recipient: recipient, Amount: amount, Reason: reason, Status: types.RefundStatusPending}
+	k.SetRefund(ctx, refund)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeRefund,
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyRecipientAddress, recipient),
+			sdk.NewAttribute(types.AttributeKeyTxHash, txhash),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	})
+}
+
+// GetRefund returns the refund of the given deposit.
+func (k Keeper) GetRefund(ctx sdk.Context, chainID string, txhash string) (types.Refund, bool) {
+	refund := types.Refund{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getRefundsKey(chainID))
+	bz := store.Get([]byte(txhash))
+	if len(bz) == 0 {
+		return refund, false
+	}
+	k.cdc.MustUnmarshal(bz, &refund)
+	return refund, true
+}
+
+// SetRefund stores the refund.
+func (k Keeper) SetRefund(ctx sdk.Context, refund types.Refund) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getRefundsKey(refund.ChainId))
+	store.Set([]byte(refund.Txhash), k.cdc.MustMarshal(&refund))
+}
+
+// DeleteRefund deletes the refund of the given deposit.
+func (k Keeper) DeleteRefund(ctx sdk.Context, chainID string, txhash string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getRefundsKey(chainID))
+	store.Delete([]byte(txhash))
+}
+
+// IterateRefunds iterates through the refunds of the given zone.
+func (k Keeper) IterateRefunds(ctx sdk.Context, chainID string, fn func(index int64, refund types.Refund) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getRefundsKey(chainID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		refund := types.Refund{}
+		k.cdc.MustUnmarshal(iterator.Value(), &refund)
+		if fn(i, refund) {
+			break
+		}
+		i++
+	}
+}
+
+// AllRefunds returns every refund of the given zone.
+func (k Keeper) AllRefunds(ctx sdk.Context, chainID string) []types.Refund {
+	refunds := []types.Refund{}
+	k.IterateRefunds(ctx, chainID, func(_ int64, refund types.Refund) bool {
+		refunds = append(refunds, refund)
+		return false
+	})
+	return refunds
+}
+
+// HandlePendingRefunds batches pending refunds of the zone into a single interchain transaction from the deposit account.
+func (k Keeper) HandlePendingRefunds(ctx sdk.Context, zone *types.RegisteredZone) error {
+	refunds := []types.Refund{}
+	k.IterateRefunds(ctx, zone.ChainId, func(_ int64, refund types.Refund) bool {
+		if refund.Status == types.RefundStatusPending {
+			refunds = append(refunds, refund)
+		}
+		return len(refunds) >= types.MaxRefundsPerBatch
+	})
+
+	if len(refunds) == 0 {
+		return nil
+	}
+
+	msgs := make([]sdk.Msg, 0, len(refunds))
+	for _, refund := range refunds {
+		msgs = append(msgs, &banktypes.MsgSend{FromAddress: zone.DepositAddress.GetAddress(), ToAddress: refund.Recipient, Amount: refund.Amount})
+	}
+
+	batch := fmt.Sprintf("%s/%d", RefundMemo, ctx.BlockHeight())
+	if err := k.SubmitTx(ctx, msgs, zone.DepositAddress, batch); err != nil {
+		return fmt.Errorf("unable to submit refunds: %w", err)
+	}
+
+	for _, refund := range refunds {
+		refund.Status = types.RefundStatusSent
+		refund.Batch = batch
+		k.SetRefund(ctx, refund)
+	}
+	return nil
+}
+
+// setSentRefundStatus updates the status of the refund sent by the MsgSend in the batch with the given memo, returning
+// false if none matched. Transactions are atomic, so identical refunds within a batch are interchangeable.
+func (k Keeper) setSentRefundStatus(ctx sdk.Context, zone *types.RegisteredZone, msg *banktypes.MsgSend, memo string, status types.RefundStatus) bool {
+	batch := refundBatch(memo)
+	var match *types.Refund
+	k.IterateRefunds(ctx, zone.ChainId, func(_ int64, refund types.Refund) bool {
+		if refund.Status == types.RefundStatusSent && refund.Batch == batch && refund.Recipient == msg.ToAddress && refund.Amount.IsEqual(msg.Amount) {
+			match = &refund
+			return true
+		}
+		return false
+	})
+	if match == nil {
+		return false
+	}
+
+	match.Status = status
+	if status == types.RefundStatusPending {
+		match.Batch = ""
+	}
+	k.SetRefund(ctx, *match)
+	return true
+}
+
+// handleCompleteRefund marks the refund returned by the MsgSend as completed.
+func (k *Keeper) handleCompleteRefund(ctx sdk.Context, zone *types.RegisteredZone, msg *banktypes.MsgSend, memo string) error {
+	if !k.setSentRefundStatus(ctx, zone, msg, memo, types.RefundStatusCompleted) {
+		return fmt.Errorf("no sent refund found for %s to %s in batch %s", msg.Amount, msg.ToAddress, memo)
+	}
+	k.Logger(ctx).Info("refund completed", "zone", zone.ChainId, "recipient", msg.ToAddress, "amount", msg.Amount)
+	return nil
+}
+
+// handleRefundTimeout requeues the refund sent by the MsgSend, so it is resubmitted on the next deposit interval.
+func (k *Keeper) handleRefundTimeout(ctx sdk.Context, zone *types.RegisteredZone, msg *banktypes.MsgSend, memo string) bool {
+	return k.setSentRefundStatus(ctx, zone, msg, memo, types.RefundStatusPending)
+}
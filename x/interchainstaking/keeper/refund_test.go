@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestRefundInvalidDeposit() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	depositAddress := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	sender := "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv"
+
+	zone := icstypes.RegisteredZone{
+		ChainId:        s.chainB.ChainID,
+		ConnectionId:   s.path.EndpointA.ConnectionID,
+		LocalDenom:     "uqatom",
+		BaseDenom:      "uatom",
+		RedemptionRate: sdk.OneDec(),
+		DepositAddress: &icstypes.ICAAccount{Address: depositAddress},
+	}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	txr := &sdk.TxResponse{
+		TxHash: "hash",
+		Events: []abcitypes.Event{{
+			Type: "transfer",
+			Attributes: []abcitypes.EventAttribute{
+				{Key: []byte("sender"), Value: []byte(sender)},
+				{Key: []byte("recipient"), Value: []byte(depositAddress)},
+				{Key: []byte("amount"), Value: []byte("1000uosmo")},
+			},
+		}},
+	}
+	app.InterchainstakingKeeper.HandleReceiptTransaction(ctx, txr, &tx.Tx{Body: &tx.TxBody{}}, zone)
+
+	refund, found := app.InterchainstakingKeeper.GetRefund(ctx, zone.ChainId, "hash")
+	s.Require().True(found)
+	s.Require().Equal(sender, refund.Recipient)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(1000))), refund.Amount)
+	s.Require().Equal(icstypes.RefundStatusPending, refund.Status)
+	s.Require().NotEmpty(refund.Reason)
+	s.Require().True(app.BankKeeper.GetSupply(ctx, "uqatom").IsZero())
+
+	res, err := app.InterchainstakingKeeper.Refunds(sdk.WrapSDKContext(ctx), &icstypes.QueryRefundsRequest{ChainId: zone.ChainId, Recipient: sender})
+	s.Require().NoError(err)
+	s.Require().Len(res.Refunds, 1)
+
+	res, err = app.InterchainstakingKeeper.Refunds(sdk.WrapSDKContext(ctx), &icstypes.QueryRefundsRequest{ChainId: zone.ChainId, Recipient: depositAddress})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Refunds)
+}
+
+func (s *KeeperTestSuite) TestHandlePendingRefunds() {
+	portID, depositAddress := s.openICAChannel(s.chainB.ChainID + ".deposit")
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	k := app.InterchainstakingKeeper
+
+	sender := "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv"
+	amount := sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(1000)))
+
+	zone := icstypes.RegisteredZone{
+		ChainId:        s.chainB.ChainID,
+		ConnectionId:   s.path.EndpointA.ConnectionID,
+		LocalDenom:     "uqatom",
+		BaseDenom:      "uatom",
+		DepositAddress: &icstypes.ICAAccount{Address: depositAddress, PortName: portID},
+	}
+	k.SetRegisteredZone(ctx, zone)
+
+	// two identical refunds are sent in separate batches.
+	k.AddRefund(ctx, zone, sender, "hash1", amount, "unsupported denom")
+	s.Require().NoError(k.HandlePendingRefunds(ctx, &zone))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.AddRefund(ctx, zone, sender, "hash2", amount, "unsupported denom")
+	s.Require().NoError(k.HandlePendingRefunds(ctx, &zone))
+
+	first, _ := k.GetRefund(ctx, zone.ChainId, "hash1")
+	second, _ := k.GetRefund(ctx, zone.ChainId, "hash2")
+	s.Require().Equal(icstypes.RefundStatusSent, first.Status)
+	s.Require().Equal(icstypes.RefundStatusSent, second.Status)
+	s.Require().NotEqual(first.Batch, second.Batch)
+	operations := k.AllIcaOperations(ctx, zone.ChainId)
+	s.Require().Len(operations, 2)
+	s.Require().Equal(first.Batch, operations[0].Memo)
+	s.Require().Equal(second.Batch, operations[1].Memo)
+
+	// sent refunds are not resubmitted.
+	s.Require().NoError(k.HandlePendingRefunds(ctx, &zone))
+	s.Require().Len(k.AllIcaOperations(ctx, zone.ChainId), 2)
+
+	// the acknowledgement of the second batch completes the second refund only.
+	send := &banktypes.MsgSend{FromAddress: depositAddress, ToAddress: sender, Amount: amount}
+	s.Require().NoError(k.HandleCompleteSend(ctx, send, second.Batch))
+	first, _ = k.GetRefund(ctx, zone.ChainId, "hash1")
+	second, _ = k.GetRefund(ctx, zone.ChainId, "hash2")
+	s.Require().Equal(icstypes.RefundStatusSent, first.Status)
+	s.Require().Equal(icstypes.RefundStatusCompleted, second.Status)
+
+	// a repeated acknowledgement matches no sent refund.
+	s.Require().Error(k.HandleCompleteSend(ctx, send, second.Batch))
+
+	// a timed out batch is requeued, and resubmitted on the next deposit interval.
+	data, err := icatypes.SerializeCosmosTx(app.AppCodec(), []sdk.Msg{send})
+	s.Require().NoError(err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data, Memo: first.Batch}
+	s.Require().NoError(k.HandleTimeout(ctx, channeltypes.Packet{Data: packetData.GetBytes()}))
+	first, _ = k.GetRefund(ctx, zone.ChainId, "hash1")
+	s.Require().Equal(icstypes.RefundStatusPending, first.Status)
+	s.Require().Empty(first.Batch)
+}
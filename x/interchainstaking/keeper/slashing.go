@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func getSlashingIncidentsKey(chainID string) []byte {
+	return append(types.KeyPrefixSlashingIncident, []byte(chainID+"/")...)
+}
+
+func getSlashingIncidentKey(incident types.SlashingIncident) []byte {
+	return append([]byte(incident.Validator+"/"), sdk.Uint64ToBigEndian(uint64(incident.Height))...)
+}
+
+// SetSlashingIncident stores the slashing incident.
+func (k Keeper) SetSlashingIncident(ctx sdk.Context, incident types.SlashingIncident) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getSlashingIncidentsKey(incident.ChainId))
+	store.Set(getSlashingIncidentKey(incident), k.cdc.MustMarshal(&incident))
+}
+
+// DeleteSlashingIncident deletes the slashing incident.
+func (k Keeper) DeleteSlashingIncident(ctx sdk.Context, incident types.SlashingIncident) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getSlashingIncidentsKey(incident.ChainId))
+	store.Delete(getSlashingIncidentKey(incident))
+}
+
+// IterateSlashingIncidents iterates through the slashing incidents of the given zone.
+func (k Keeper) IterateSlashingIncidents(ctx sdk.Context, chainID string, fn func(index int64, incident types.SlashingIncident) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getSlashingIncidentsKey(chainID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		incident := types.SlashingIncident{}
+		k.cdc.MustUnmarshal(iterator.Value(), &incident)
+		if fn(i, incident) {
+			break
+		}
+		i++
+	}
+}
+
+// AllSlashingIncidents returns every slashing incident of the given zone.
+func (k Keeper) AllSlashingIncidents(ctx sdk.Context, chainID string) []types.SlashingIncident {
+	incidents := []types.SlashingIncident{}
+	k.IterateSlashingIncidents(ctx, chainID, func(_ int64, incident types.SlashingIncident) bool {
+		incidents = append(incidents, incident)
+		return false
+	})
+	return incidents
+}
+
+// HandleValidatorSlash rescales the zone's delegations to a slashed validator by the fall in the validator's token to
+// share ratio, from prevTokens/prevShares to tokens/shares, and records the incident. The caller is responsible for
+// storing the zone and updating the redemption rate.
+func (k Keeper) HandleValidatorSlash(ctx sdk.Context, zone *types.RegisteredZone, valoper string, prevTokens sdk.Int, prevShares sdk.Dec, tokens sdk.Int, shares sdk.Dec) types.SlashingIncident {
+	prevRate := prevTokens.ToDec().Quo(prevShares)
+	rate := tokens.ToDec().Quo(shares)
+
+	delegations := []types.Delegation{}
+	k.IterateAllDelegations(ctx, zone, func(delegation types.Delegation) bool {
+		if delegation.ValidatorAddress == valoper {
+			delegations = append(delegations, delegation)
+		}
+		return false
+	})
+
+	delegated := zone.GetDelegatedAmount().Amount
+	tokensLost := sdk.ZeroInt()
+	for _, delegation := range delegations {
+		// delegation amounts were recorded at the previous ratio; convert to shares, then back to tokens at the new ratio.
+		delegationShares := delegation.Amount.Amount.ToDec().Quo(prevRate)
+		amount := delegationShares.Mul(rate).TruncateInt()
+		lost := delegation.Amount.Amount.Sub(amount)
+		if !lost.IsPositive() {
+			continue
+		}
+
+		delegation.Amount.Amount = amount
+		k.SetDelegation(ctx, zone, delegation)
+		tokensLost = tokensLost.Add(lost)
+
+		if account, err := zone.GetDelegationAccountByAddress(delegation.DelegationAddress); err == nil {
+			account.DelegatedBalance = sdk.NewCoin(zone.BaseDenom, sdk.MaxInt(account.DelegatedBalance.Amount.Sub(lost), sdk.ZeroInt()))
+		}
+	}
+
+	k.applySlashToRedemptionRate(ctx, zone, delegated, tokensLost)
+
+	incident := types.SlashingIncident{
+		ChainId:    zone.ChainId,
+		Validator:  valoper,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime(),
+		Fraction:   sdk.OneDec().Sub(rate.Quo(prevRate)),
+		TokensLost: tokensLost,
+	}
+	k.SetSlashingIncident(ctx, incident)
+
+	k.Logger(ctx).Info("Validator slashed", "zone", zone.ChainId, "valoper", valoper, "fraction", incident.Fraction, "tokensLost", tokensLost)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeValidatorSlashed,
+			sdk.NewAttribute(types.AttributeKeyRecipientChain, zone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, valoper),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, incident.Fraction.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokensLost.String()),
+		),
+	})
+
+	return incident
+}
+
+// applySlashToRedemptionRate reduces the redemption rate of the zone by the proportion of its delegated tokens lost to
+// a slash. Only the loss is applied; deposits in flight are not yet delegated, and rewards are accounted for at the
+// epoch, so the rate is not recalculated, and the last redemption rate is retained for the circuit breaker.
+func (k Keeper) applySlashToRedemptionRate(ctx sdk.Context, zone *types.RegisteredZone, delegated sdk.Int, tokensLost sdk.Int) {
+	if zone.Sunsetting || !tokensLost.IsPositive() || !delegated.IsPositive() || zone.RedemptionRate.IsNil() {
+		return
+	}
+	rate := zone.RedemptionRate.MulInt(sdk.MaxInt(delegated.Sub(tokensLost), sdk.ZeroInt())).QuoInt(delegated)
+	k.Logger(ctx).Info("Reducing redemption rate for slash", "zone", zone.ChainId, "rate", zone.RedemptionRate, "new", rate, "tokensLost", tokensLost)
+	zone.RedemptionRate = rate
+}
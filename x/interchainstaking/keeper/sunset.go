@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// SunsetMemo is the memo of interchain transactions unbonding the delegations of a sunsetting zone.
+const SunsetMemo = "sunset"
+
+// SunsetZone stops deposits to the zone, freezes its redemption rate and unbonds all of its delegations. Queued
+// withdrawals are covered by the unbonding, and are paid out by PayoutUnbondedWithdrawals once it completes.
+func (k Keeper) SunsetZone(ctx sdk.Context, zone *types.RegisteredZone) error {
+	// the final rate is the lower of the last two rates, as used for redemptions.
+	rate := zone.RedemptionRate
+	if zone.LastRedemptionRate.LT(rate) {
+		rate = zone.LastRedemptionRate
+	}
+	zone.RedemptionRate = rate
+	zone.LastRedemptionRate = rate
+	zone.Sunsetting = true
+	k.SetRegisteredZone(ctx, *zone)
+
+	return k.unbondSunsetDelegations(ctx, zone)
+}
+
+// unbondSunsetDelegations unbonds the remaining delegations of a sunsetting zone, skipping delegation accounts whose
+// unbonding awaits acknowledgement. Acknowledged unbondings are removed from the delegation records, so unbondings
+// that timed out or failed are issued again. If nothing remains to be unbonded and no unbonding has been
+// acknowledged, the sunset completes immediately.
+func (k Keeper) unbondSunsetDelegations(ctx sdk.Context, zone *types.RegisteredZone) error {
+	unbonding := false
+	for _, da := range zone.GetDelegationAccounts() {
+		if k.hasPendingIcaOperation(ctx, zone.ChainId, da.PortName, SunsetMemo) {
+			unbonding = true
+			continue
+		}
+
+		_, delAddr, err := bech32.DecodeAndConvert(da.Address)
+		if err != nil {
+			return err
+		}
+
+		delegations := k.GetDelegatorDelegations(ctx, zone, delAddr)
+		sort.SliceStable(delegations, func(i, j int) bool {
+			return delegations[i].ValidatorAddress < delegations[j].ValidatorAddress
+		})
+
+		msgs := make([]sdk.Msg, 0, len(delegations))
+		for _, delegation := range delegations {
+			if !delegation.Amount.IsPositive() {
+				continue
+			}
+			msgs = append(msgs, &stakingtypes.MsgUndelegate{
+				DelegatorAddress: da.Address,
+				ValidatorAddress: delegation.ValidatorAddress,
+				Amount:           delegation.Amount,
+			})
+		}
+
+		if len(msgs) == 0 {
+			continue
+		}
+
+		if err := k.SubmitTx(ctx, msgs, da, SunsetMemo); err != nil {
+			k.Logger(ctx).Error("error submitting sunset unbonding tx", "delegator", da.Address, "err", err)
+			return err
+		}
+		unbonding = true
+
+		// NOTE: setting records mid-iteration breaks the iterator; cache the results and update retrospectively.
+		queued := []types.WithdrawalRecord{}
+		k.IterateWithdrawalRecords(ctx, da.Address, func(_ int64, record types.WithdrawalRecord) bool {
+			if record.Status == types.WithdrawStatusQueued {
+				queued = append(queued, record)
+			}
+			return false
+		})
+		for _, record := range queued {
+			record := record
+			record.Status = types.WithdrawStatusUnbond
+			record.UnbondingBatch = SunsetMemo
+			k.SetWithdrawalRecord(ctx, &record)
+		}
+	}
+
+	if !unbonding && zone.SunsetCompletion.IsZero() {
+		zone.SunsetCompletion = ctx.BlockTime()
+		k.SetRegisteredZone(ctx, *zone)
+		k.Logger(ctx).Info("zone has no delegations to unbond; sunset unbonding complete", "zone", zone.ChainId)
+	}
+
+	return nil
+}
+
+// processSunsetRedemption pays out a redemption from a sunsetting zone directly from the unbonded balances of its
+// delegation accounts. Redemptions are only available once all delegations have been unbonded.
+func (k *Keeper) processSunsetRedemption(ctx sdk.Context, zone types.RegisteredZone, recipient string, outTokens sdk.Coin, burnAmount sdk.Coin, hash string) (sdk.Coins, error) {
+	if len(k.GetAllDelegations(ctx, &zone)) > 0 || zone.SunsetCompletion.IsZero() || zone.SunsetCompletion.After(ctx.BlockTime()) {
+		return nil, fmt.Errorf("zone %s is sunsetting; redemptions are unavailable until unbonding has completed", zone.ChainId)
+	}
+
+	// copy the accounts, as sorting in place would reorder the zone's delegation addresses.
+	accounts := make([]*types.ICAAccount, len(zone.GetDelegationAccounts()))
+	copy(accounts, zone.GetDelegationAccounts())
+	sort.SliceStable(accounts, func(i, j int) bool {
+		return accounts[i].Balance.AmountOf(zone.BaseDenom).GT(accounts[j].Balance.AmountOf(zone.BaseDenom))
+	})
+
+	sumAmount := sdk.NewCoins()
+	remaining := outTokens.Amount
+	for _, da := range accounts {
+		if remaining.IsZero() {
+			break
+		}
+		available := da.Balance.AmountOf(zone.BaseDenom)
+		if !available.IsPositive() {
+			continue
+		}
+
+		amount := sdk.NewCoin(zone.BaseDenom, sdk.MinInt(available, remaining))
+		// sunset payouts are made from unbonded balances, so are not attributed to a validator; the record is keyed
+		// by delegator and recipient alone.
+		k.AddWithdrawalRecord(ctx, da.Address, "", recipient, amount, burnAmount, hash, types.WithdrawStatusSend)
+		if err := k.SubmitTx(ctx, []sdk.Msg{&banktypes.MsgSend{FromAddress: da.Address, ToAddress: recipient, Amount: sdk.NewCoins(amount)}}, da, hash); err != nil {
+			k.Logger(ctx).Error("error submitting sunset redemption tx", "delegator", da.Address, "hash", hash, "err", err)
+			return nil, err
+		}
+
+		da.Balance = da.Balance.Sub(sdk.NewCoins(amount))
+		remaining = remaining.Sub(amount.Amount)
+		sumAmount = sumAmount.Add(amount)
+	}
+
+	if remaining.IsPositive() {
+		return nil, fmt.Errorf("insufficient unbonded balance to redeem %s from zone %s", outTokens, zone.ChainId)
+	}
+
+	k.SetRegisteredZone(ctx, zone)
+	return sumAmount, nil
+}
+
+// HandleSunsetEpoch re-issues the unbonding of a sunsetting zone's remaining delegations, and refreshes the balances of
+// its delegation accounts once unbonding has completed, so that they are available for redemption.
+func (k Keeper) HandleSunsetEpoch(ctx sdk.Context, zone types.RegisteredZone) {
+	if err := k.unbondSunsetDelegations(ctx, &zone); err != nil {
+		k.Logger(ctx).Error("error unbonding sunset delegations", "zone", zone.ChainId, "err", err)
+	}
+
+	if zone.SunsetCompletion.IsZero() || zone.SunsetCompletion.After(ctx.BlockTime()) {
+		return
+	}
+
+	for _, da := range zone.GetDelegationAccounts() {
+		balanceQuery := banktypes.QueryAllBalancesRequest{Address: da.Address}
+		k.ICQKeeper.MakeRequest(
+			ctx,
+			zone.ConnectionId,
+			zone.ChainId,
+			"cosmos.bank.v1beta1.Query/AllBalances",
+			k.cdc.MustMarshal(&balanceQuery),
+			sdk.NewInt(-1),
+			types.ModuleName,
+			"allbalances",
+			0,
+		)
+	}
+}
+
+// IsSunsetComplete returns true if the zone is sunsetting, all of its qAssets have been redeemed and all
+// withdrawals have been paid out or have failed; the zone may then be removed.
+func (k Keeper) IsSunsetComplete(ctx sdk.Context, zone types.RegisteredZone) bool {
+	if !zone.Sunsetting || !k.BankKeeper.GetSupply(ctx, zone.LocalDenom).IsZero() {
+		return false
+	}
+	for _, da := range zone.GetDelegationAccounts() {
+		pending := false
+		k.IterateWithdrawalRecords(ctx, da.Address, func(_ int64, record types.WithdrawalRecord) bool {
+			pending = record.Status != types.WithdrawStatusCompleted && record.Status != types.WithdrawStatusFailed
+			return pending
+		})
+		if pending {
+			return false
+		}
+	}
+	return true
+}
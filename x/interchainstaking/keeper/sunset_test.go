@@ -0,0 +1,300 @@
+package keeper_test
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icskeeper "github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestDeregisterZoneProposal() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone := icstypes.RegisteredZone{
+		ChainId:            s.chainB.ChainID,
+		ConnectionId:       s.path.EndpointA.ConnectionID,
+		LocalDenom:         "uqatom",
+		BaseDenom:          "uatom",
+		RedemptionRate:     sdk.NewDecWithPrec(11, 1),
+		LastRedemptionRate: sdk.NewDecWithPrec(105, 2),
+	}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	proposal := icstypes.NewDeregisterZoneProposal("deregister", "sunset zone", s.chainB.ChainID)
+	s.Require().NoError(icskeeper.HandleDeregisterZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+
+	zone, found := app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, s.chainB.ChainID)
+	s.Require().True(found)
+	s.Require().True(zone.Sunsetting)
+	s.Require().Equal(sdk.NewDecWithPrec(105, 2), zone.RedemptionRate)
+	s.Require().Equal(sdk.NewDecWithPrec(105, 2), zone.LastRedemptionRate)
+	// the zone has nothing to unbond, so its unbonding completes immediately.
+	s.Require().True(zone.SunsetCompletion.Equal(ctx.BlockTime()))
+
+	// a second proposal for the same zone is rejected.
+	s.Require().Error(icskeeper.HandleDeregisterZoneProposal(ctx, app.InterchainstakingKeeper, proposal))
+}
+
+func (s *KeeperTestSuite) TestDeleteRegisteredZonePurgesState() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	delegator := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	user := "quick17dtl0mjt3t77kpuhg2edqzjpszulwhgzkcjrjl"
+
+	zone := icstypes.RegisteredZone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, LocalDenom: "uqatom", BaseDenom: "uatom"}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	app.InterchainstakingKeeper.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegator, validator, sdk.NewCoin("uatom", sdk.NewInt(1000))))
+	app.InterchainstakingKeeper.SetIntent(ctx, zone, icstypes.DelegatorIntent{Delegator: user, Intents: []*icstypes.ValidatorIntent{{ValoperAddress: validator, Weight: sdk.OneDec()}}}, false)
+	app.InterchainstakingKeeper.SetIntent(ctx, zone, icstypes.DelegatorIntent{Delegator: user, Intents: []*icstypes.ValidatorIntent{{ValoperAddress: validator, Weight: sdk.OneDec()}}}, true)
+	app.InterchainstakingKeeper.SetReceipt(ctx, icstypes.Receipt{Zone: &zone, Sender: user, Txhash: "hash", Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000)))})
+	app.InterchainQueryKeeper.MakeRequest(ctx, zone.ConnectionId, zone.ChainId, "cosmos.bank.v1beta1.Query/AllBalances", []byte{}, sdk.NewInt(-1), icstypes.ModuleName, "allbalances", 0)
+
+	app.InterchainstakingKeeper.DeleteRegisteredZone(ctx, zone.ChainId)
+
+	_, found := app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	s.Require().False(found)
+	s.Require().Empty(app.InterchainstakingKeeper.GetAllDelegations(ctx, &zone))
+	s.Require().Empty(app.InterchainstakingKeeper.AllIntents(ctx, zone, false))
+	s.Require().Empty(app.InterchainstakingKeeper.AllIntents(ctx, zone, true))
+	_, found = app.InterchainstakingKeeper.GetReceipt(ctx, icskeeper.GetReceiptKey(zone, "hash"))
+	s.Require().False(found)
+	for _, query := range app.InterchainQueryKeeper.AllQueries(ctx) {
+		s.Require().NotEqual(zone.ChainId, query.ChainId)
+	}
+}
+
+func (s *KeeperTestSuite) TestSunsetRedemption() {
+	portA, addressA := s.openICAChannel(s.chainB.ChainID + ".delegate.0")
+	portB, addressB := s.openICAChannel(s.chainB.ChainID + ".delegate.1")
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	k := app.InterchainstakingKeeper
+
+	recipient := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	user := sdk.AccAddress([]byte("user________________"))
+
+	// the account sorted last by address holds the larger balance, so is drawn from first.
+	accounts := []*icstypes.ICAAccount{
+		{Address: addressA, PortName: portA, DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())},
+		{Address: addressB, PortName: portB, DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())},
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Address < accounts[j].Address })
+	accounts[0].Balance = sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))
+	accounts[1].Balance = sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(300)))
+
+	zone := icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		AccountPrefix:       "cosmos",
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		RedemptionRate:      sdk.OneDec(),
+		LastRedemptionRate:  sdk.OneDec(),
+		DelegationAddresses: accounts,
+		Sunsetting:          true,
+		SunsetCompletion:    ctx.BlockTime(),
+	}
+	k.SetRegisteredZone(ctx, zone)
+
+	qAssets := sdk.NewCoin("uqatom", sdk.NewInt(350))
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(qAssets)))
+	s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, icstypes.ModuleName, user, sdk.NewCoins(qAssets)))
+
+	msgSrv := icskeeper.NewMsgServerImpl(k)
+	_, err := msgSrv.RequestRedemption(sdk.WrapSDKContext(ctx), &icstypes.MsgRequestRedemption{Coin: qAssets.String(), DestinationAddress: recipient, FromAddress: user.String()})
+	s.Require().NoError(err)
+
+	// the redemption is paid out from the unbonded balances, largest first, without reordering the zone's accounts.
+	zone, found := k.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	s.Require().True(found)
+	s.Require().Equal(accounts[0].Address, zone.DelegationAddresses[0].Address)
+	s.Require().Equal(accounts[1].Address, zone.DelegationAddresses[1].Address)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(50))), zone.DelegationAddresses[0].Balance)
+	s.Require().True(zone.DelegationAddresses[1].Balance.IsZero())
+
+	records := k.AllWithdrawalRecords(ctx, accounts[1].Address)
+	s.Require().Len(records, 1)
+	s.Require().Equal(icstypes.WithdrawStatusSend, records[0].Status)
+	s.Require().Equal(sdk.NewCoin("uatom", sdk.NewInt(300)), records[0].Amount)
+	s.Require().Empty(records[0].Validator)
+	s.Require().Len(k.AllWithdrawalRecords(ctx, accounts[0].Address), 1)
+}
+
+func (s *KeeperTestSuite) TestSunsetCompletion() {
+	port, address := s.openICAChannel(s.chainB.ChainID + ".delegate.0")
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	k := app.InterchainstakingKeeper
+
+	recipient := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	user := sdk.AccAddress([]byte("user________________"))
+
+	zone := icstypes.RegisteredZone{
+		ChainId:            s.chainB.ChainID,
+		ConnectionId:       s.path.EndpointA.ConnectionID,
+		AccountPrefix:      "cosmos",
+		LocalDenom:         "uqatom",
+		BaseDenom:          "uatom",
+		RedemptionRate:     sdk.OneDec(),
+		LastRedemptionRate: sdk.OneDec(),
+		DelegationAddresses: []*icstypes.ICAAccount{
+			{Address: address, PortName: port, Balance: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))), DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())},
+		},
+		Sunsetting:       true,
+		SunsetCompletion: ctx.BlockTime(),
+	}
+	k.SetRegisteredZone(ctx, zone)
+
+	qAssets := sdk.NewCoin("uqatom", sdk.NewInt(100))
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(qAssets)))
+	s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, icstypes.ModuleName, user, sdk.NewCoins(qAssets)))
+
+	msgSrv := icskeeper.NewMsgServerImpl(k)
+	_, err := msgSrv.RequestRedemption(sdk.WrapSDKContext(ctx), &icstypes.MsgRequestRedemption{Coin: qAssets.String(), DestinationAddress: recipient, FromAddress: user.String()})
+	s.Require().NoError(err)
+	s.Require().False(k.IsSunsetComplete(ctx, zone))
+
+	// the payout is acknowledged, completing the withdrawal and burning the escrowed qAssets.
+	operations := k.AllIcaOperations(ctx, zone.ChainId)
+	s.Require().Len(operations, 1)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: operations[0].Data, Memo: operations[0].Memo}
+	packet := channeltypes.Packet{SourcePort: operations[0].PortId, SourceChannel: operations[0].ChannelId, Sequence: operations[0].Sequence, Data: packetData.GetBytes()}
+	txMsgData, err := (&sdk.TxMsgData{Data: []*sdk.MsgData{{MsgType: operations[0].MsgTypes[0]}}}).Marshal()
+	s.Require().NoError(err)
+	s.Require().NoError(k.HandleAcknowledgement(ctx, packet, channeltypes.NewResultAcknowledgement(txMsgData).Acknowledgement()))
+
+	records := k.AllWithdrawalRecords(ctx, address)
+	s.Require().Len(records, 1)
+	s.Require().Equal(icstypes.WithdrawStatusCompleted, records[0].Status)
+	s.Require().True(app.BankKeeper.GetSupply(ctx, zone.LocalDenom).IsZero())
+
+	// completed withdrawals do not hold up the sunset, and are removed with the zone.
+	s.Require().True(k.IsSunsetComplete(ctx, zone))
+	k.AfterEpochEnd(ctx, "epoch", 1)
+	_, found := k.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	s.Require().False(found)
+	s.Require().Empty(k.AllWithdrawalRecords(ctx, address))
+}
+
+func (s *KeeperTestSuite) TestSunsetUnbonding() {
+	port, address := s.openICAChannel(s.chainB.ChainID + ".delegate.0")
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	k := app.InterchainstakingKeeper
+
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+
+	zone := icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		AccountPrefix:       "cosmos",
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		RedemptionRate:      sdk.OneDec(),
+		LastRedemptionRate:  sdk.OneDec(),
+		DelegationAddresses: []*icstypes.ICAAccount{{Address: address, PortName: port, DelegatedBalance: sdk.NewCoin("uatom", sdk.NewInt(1000))}},
+	}
+	k.SetRegisteredZone(ctx, zone)
+	k.SetDelegation(ctx, &zone, icstypes.NewDelegation(address, validator, sdk.NewCoin("uatom", sdk.NewInt(1000))))
+
+	pending := func() []icstypes.IcaOperation {
+		operations := []icstypes.IcaOperation{}
+		for _, operation := range k.AllIcaOperations(ctx, zone.ChainId) {
+			if operation.Status == icstypes.IcaOperationPending {
+				s.Require().Equal(icskeeper.SunsetMemo, operation.Memo)
+				operations = append(operations, operation)
+			}
+		}
+		return operations
+	}
+	packet := func(operation icstypes.IcaOperation) channeltypes.Packet {
+		packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: operation.Data, Memo: operation.Memo}
+		return channeltypes.Packet{SourcePort: operation.PortId, SourceChannel: operation.ChannelId, Sequence: operation.Sequence, Data: packetData.GetBytes()}
+	}
+	epoch := func() {
+		zone, found := k.GetRegisteredZoneInfo(ctx, zone.ChainId)
+		s.Require().True(found)
+		k.HandleEpochForZone(ctx, zone, 1)
+	}
+
+	s.Require().NoError(k.SunsetZone(ctx, &zone))
+	s.Require().Len(pending(), 1)
+	s.Require().True(zone.SunsetCompletion.IsZero())
+
+	// the unbonding is not issued again while it awaits acknowledgement, and rewards are not withdrawn to be delegated.
+	epoch()
+	s.Require().Len(pending(), 1)
+	zone, found := k.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	s.Require().True(found)
+	s.Require().Zero(zone.WithdrawalWaitgroup)
+	for _, query := range app.InterchainQueryKeeper.AllQueries(ctx) {
+		s.Require().NotEqual("rewards", query.CallbackId)
+	}
+
+	// a timed out unbonding is issued again at the next epoch.
+	s.Require().NoError(k.HandleTimeout(ctx, packet(pending()[0])))
+	s.Require().Empty(pending())
+	epoch()
+	s.Require().Len(pending(), 1)
+
+	// the acknowledged unbonding is removed from the delegation records, and sets the sunset completion.
+	completion := ctx.BlockTime().Add(21 * 24 * time.Hour)
+	response, err := (&stakingtypes.MsgUndelegateResponse{CompletionTime: completion}).Marshal()
+	s.Require().NoError(err)
+	txMsgData, err := (&sdk.TxMsgData{Data: []*sdk.MsgData{{MsgType: "/cosmos.staking.v1beta1.MsgUndelegate", Data: response}}}).Marshal()
+	s.Require().NoError(err)
+	s.Require().NoError(k.HandleAcknowledgement(ctx, packet(pending()[0]), channeltypes.NewResultAcknowledgement(txMsgData).Acknowledgement()))
+
+	zone, found = k.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	s.Require().True(found)
+	s.Require().True(zone.SunsetCompletion.Equal(completion))
+	s.Require().True(zone.DelegationAddresses[0].DelegatedBalance.IsZero())
+	s.Require().Empty(k.GetAllDelegations(ctx, &zone))
+
+	epoch()
+	s.Require().Empty(pending())
+}
+
+func (s *KeeperTestSuite) TestSunsetRewardsNotDelegated() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	k := app.InterchainstakingKeeper
+
+	delegator := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	withdrawal := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+	zone := icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		WithdrawalAddress:   &icstypes.ICAAccount{Address: withdrawal},
+		DelegationAddresses: []*icstypes.ICAAccount{{Address: delegator}},
+		Sunsetting:          true,
+	}
+	k.SetRegisteredZone(ctx, zone)
+
+	// rewards distributed to a delegation account of a sunsetting zone remain there for redemption.
+	msg := &banktypes.MsgSend{FromAddress: withdrawal, ToAddress: delegator, Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))}
+	s.Require().NoError(k.HandleCompleteSend(ctx, msg, ""))
+	s.Require().Empty(k.AllIcaOperations(ctx, zone.ChainId))
+}
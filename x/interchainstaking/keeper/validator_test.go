@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorStatusTracking() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	zone := icstypes.RegisteredZone{
+		ChainId:       s.chainB.ChainID,
+		ConnectionId:  s.path.EndpointA.ConnectionID,
+		AccountPrefix: "cosmos",
+		LocalDenom:    "uqatom",
+		BaseDenom:     "uatom",
+	}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	pk := ed25519.GenPrivKey().PubKey()
+	valoper, err := bech32.ConvertAndEncode("cosmosvaloper", pk.Address())
+	s.Require().NoError(err)
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(pk.Address()), pk, stakingtypes.Description{})
+	s.Require().NoError(err)
+	validator.OperatorAddress = valoper
+	validator.Status = stakingtypes.Bonded
+	validator.Jailed = true
+
+	bz, err := app.AppCodec().Marshal(&validator)
+	s.Require().NoError(err)
+	s.Require().NoError(keeper.SetValidatorForZone(app.InterchainstakingKeeper, ctx, zone, bz))
+
+	zone, _ = app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	val, err := zone.GetValidatorByValoper(valoper)
+	s.Require().NoError(err)
+	s.Require().True(val.Jailed)
+	s.Require().False(val.Tombstoned)
+	s.Require().Equal(stakingtypes.Bonded.String(), val.Status)
+	s.Require().NotEmpty(val.ConsensusAddress)
+	s.Require().True(zone.IsValidatorExcluded(valoper))
+
+	info := slashingtypes.ValidatorSigningInfo{Address: val.ConsensusAddress, Tombstoned: true}
+	bz, err = app.AppCodec().Marshal(&info)
+	s.Require().NoError(err)
+	s.Require().NoError(keeper.SetSigningInfoForZone(app.InterchainstakingKeeper, ctx, zone, bz))
+
+	zone, _ = app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	val, err = zone.GetValidatorByValoper(valoper)
+	s.Require().NoError(err)
+	s.Require().True(val.Tombstoned)
+}
+
+func (s *KeeperTestSuite) TestValidatorSlashDetection() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	delegator := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+
+	zone := icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		AccountPrefix:       "cosmos",
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		RedemptionRate:      sdk.OneDec(),
+		LastRedemptionRate:  sdk.OneDec(),
+		DelegationAddresses: []*icstypes.ICAAccount{{Address: delegator, DelegatedBalance: sdk.NewCoin("uatom", sdk.NewInt(500))}},
+	}
+
+	pk := ed25519.GenPrivKey().PubKey()
+	valoper, err := bech32.ConvertAndEncode("cosmosvaloper", pk.Address())
+	s.Require().NoError(err)
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(pk.Address()), pk, stakingtypes.Description{})
+	s.Require().NoError(err)
+	validator.OperatorAddress = valoper
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = sdk.NewInt(1000)
+	validator.DelegatorShares = sdk.NewDec(1000)
+
+	zone.Validators = []*icstypes.Validator{{ValoperAddress: valoper, CommissionRate: sdk.ZeroDec(), VotingPower: validator.Tokens, DelegatorShares: validator.DelegatorShares, Score: sdk.ZeroDec(), Status: validator.Status.String()}}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+	app.InterchainstakingKeeper.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegator, valoper, sdk.NewCoin("uatom", sdk.NewInt(500))))
+	// qAssets have been minted for a deposit that is not yet delegated.
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(600)))))
+
+	// a 10% slash reduces tokens, but not shares.
+	validator.Tokens = sdk.NewInt(900)
+	bz, err := app.AppCodec().Marshal(&validator)
+	s.Require().NoError(err)
+	s.Require().NoError(keeper.SetValidatorForZone(app.InterchainstakingKeeper, ctx, zone, bz))
+
+	zone, _ = app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	delegation, found := app.InterchainstakingKeeper.GetDelegation(ctx, &zone, delegator, valoper)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(450), delegation.Amount.Amount)
+	s.Require().Equal(sdk.NewInt(450), zone.DelegationAddresses[0].DelegatedBalance.Amount)
+	// the rate is reduced by the loss alone, leaving the last rate unchanged.
+	s.Require().Equal(sdk.NewDecWithPrec(9, 1), zone.RedemptionRate)
+	s.Require().Equal(sdk.OneDec(), zone.LastRedemptionRate)
+
+	incidents := app.InterchainstakingKeeper.AllSlashingIncidents(ctx, zone.ChainId)
+	s.Require().Len(incidents, 1)
+	s.Require().Equal(sdk.NewDecWithPrec(1, 1), incidents[0].Fraction)
+	s.Require().Equal(sdk.NewInt(50), incidents[0].TokensLost)
+
+	res, err := app.InterchainstakingKeeper.SlashingIncidents(sdk.WrapSDKContext(ctx), &icstypes.QuerySlashingIncidentsRequest{ChainId: zone.ChainId, Validator: valoper})
+	s.Require().NoError(err)
+	s.Require().Len(res.Incidents, 1)
+
+	// a subsequent update without a change in ratio is not a slash.
+	validator.Tokens = sdk.NewInt(1800)
+	validator.DelegatorShares = sdk.NewDec(2000)
+	bz, err = app.AppCodec().Marshal(&validator)
+	s.Require().NoError(err)
+	s.Require().NoError(keeper.SetValidatorForZone(app.InterchainstakingKeeper, ctx, zone, bz))
+	s.Require().Len(app.InterchainstakingKeeper.AllSlashingIncidents(ctx, zone.ChainId), 1)
+}
+
+func (s *KeeperTestSuite) TestPaginatedValsetIngestion() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	newValidator := func() stakingtypes.Validator {
+		pk := ed25519.GenPrivKey().PubKey()
+		valoper, err := bech32.ConvertAndEncode("cosmosvaloper", pk.Address())
+		s.Require().NoError(err)
+		validator, err := stakingtypes.NewValidator(sdk.ValAddress(pk.Address()), pk, stakingtypes.Description{})
+		s.Require().NoError(err)
+		validator.OperatorAddress = valoper
+		validator.Status = stakingtypes.Bonded
+		return validator
+	}
+	present := newValidator()
+	absent := newValidator()
+
+	zone := icstypes.RegisteredZone{
+		ChainId:       s.chainB.ChainID,
+		ConnectionId:  s.path.EndpointA.ConnectionID,
+		AccountPrefix: "cosmos",
+		LocalDenom:    "uqatom",
+		BaseDenom:     "uatom",
+	}
+	for _, validator := range []stakingtypes.Validator{present, absent} {
+		zone.Validators = append(zone.Validators, &icstypes.Validator{ValoperAddress: validator.OperatorAddress, CommissionRate: validator.GetCommission(), VotingPower: validator.Tokens, DelegatorShares: validator.DelegatorShares, Score: sdk.ZeroDec(), Status: stakingtypes.BondStatusBonded})
+	}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+
+	// the first page returns a next key, so the following page is requested.
+	res := stakingtypes.QueryValidatorsResponse{Validators: []stakingtypes.Validator{present}, Pagination: &query.PageResponse{NextKey: []byte("next")}}
+	bz, err := app.AppCodec().Marshal(&res)
+	s.Require().NoError(err)
+	s.Require().NoError(keeper.SetValidatorsForZone(app.InterchainstakingKeeper, ctx, zone, bz, stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}))
+
+	found := false
+	app.InterchainQueryKeeper.IterateQueries(ctx, func(_ int64, q icqtypes.Query) bool {
+		request := stakingtypes.QueryValidatorsRequest{}
+		if q.CallbackId == "valset" && app.AppCodec().Unmarshal(q.Request, &request) == nil && request.Pagination != nil {
+			found = string(request.Pagination.Key) == "next" && request.Status == stakingtypes.BondStatusBonded
+		}
+		return found
+	})
+	s.Require().True(found)
+
+	// the final page completes the pass; the validator absent from every page is marked unbonded.
+	res = stakingtypes.QueryValidatorsResponse{Pagination: &query.PageResponse{}}
+	bz, err = app.AppCodec().Marshal(&res)
+	s.Require().NoError(err)
+	s.Require().NoError(keeper.SetValidatorsForZone(app.InterchainstakingKeeper, ctx, zone, bz, stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded, Pagination: &query.PageRequest{Key: []byte("next")}}))
+
+	zone, _ = app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	val, err := zone.GetValidatorByValoper(present.OperatorAddress)
+	s.Require().NoError(err)
+	s.Require().Equal(stakingtypes.BondStatusBonded, val.Status)
+	val, err = zone.GetValidatorByValoper(absent.OperatorAddress)
+	s.Require().NoError(err)
+	s.Require().Equal(stakingtypes.BondStatusUnbonded, val.Status)
+	s.Require().False(app.InterchainstakingKeeper.IsValsetPassValidator(ctx, zone.ChainId, stakingtypes.BondStatusBonded, present.OperatorAddress))
+}
+
+func (s *KeeperTestSuite) TestRemovedValidatorPruning() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	delegator := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	removed := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	delegated := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	removedValoper, err := bech32.ConvertAndEncode("cosmosvaloper", removed)
+	s.Require().NoError(err)
+	delegatedValoper, err := bech32.ConvertAndEncode("cosmosvaloper", delegated)
+	s.Require().NoError(err)
+
+	zone := icstypes.RegisteredZone{
+		ChainId:       s.chainB.ChainID,
+		ConnectionId:  s.path.EndpointA.ConnectionID,
+		AccountPrefix: "cosmos",
+		LocalDenom:    "uqatom",
+		BaseDenom:     "uatom",
+		Validators: []*icstypes.Validator{
+			{ValoperAddress: removedValoper, Status: stakingtypes.BondStatusUnbonded, VotingPower: sdk.ZeroInt(), DelegatorShares: sdk.ZeroDec(), CommissionRate: sdk.ZeroDec(), Score: sdk.ZeroDec()},
+			{ValoperAddress: delegatedValoper, Status: stakingtypes.BondStatusUnbonded, VotingPower: sdk.ZeroInt(), DelegatorShares: sdk.ZeroDec(), CommissionRate: sdk.ZeroDec(), Score: sdk.ZeroDec()},
+		},
+	}
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, zone)
+	app.InterchainstakingKeeper.SetDelegation(ctx, &zone, icstypes.NewDelegation(delegator, delegatedValoper, sdk.NewCoin("uatom", sdk.NewInt(1000))))
+
+	// an empty validator query response prunes the validator, unless delegations to it are still recorded.
+	for _, addr := range []sdk.ValAddress{removed, delegated} {
+		query := icqtypes.Query{ChainId: zone.ChainId, ConnectionId: zone.ConnectionId, Request: stakingtypes.GetValidatorKey(addr)}
+		s.Require().NoError(keeper.ValidatorCallback(app.InterchainstakingKeeper, ctx, nil, query))
+	}
+
+	zone, _ = app.InterchainstakingKeeper.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	s.Require().Len(zone.Validators, 1)
+	s.Require().Equal(delegatedValoper, zone.Validators[0].ValoperAddress)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func getValsetPassKey(chainID string, status string) []byte {
+	return append(types.KeyPrefixValsetPass, []byte(chainID+"/"+status+"/")...)
+}
+
+// SetValsetPassValidator records that the validator was seen in the current pass over the zone's validators of the
+// given status.
+func (k Keeper) SetValsetPassValidator(ctx sdk.Context, chainID string, status string, valoper string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getValsetPassKey(chainID, status))
+	store.Set([]byte(valoper), []byte{0x01})
+}
+
+// IsValsetPassValidator returns true if the validator was seen in the current pass over the zone's validators of the
+// given status.
+func (k Keeper) IsValsetPassValidator(ctx sdk.Context, chainID string, status string, valoper string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getValsetPassKey(chainID, status))
+	return store.Has([]byte(valoper))
+}
+
+// ClearValsetPass removes the record of the current pass over the zone's validators of the given status.
+func (k Keeper) ClearValsetPass(ctx sdk.Context, chainID string, status string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), getValsetPassKey(chainID, status))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// AllValsetPasses returns the validators seen in the current passes over the zone's validators, per status.
+func (k Keeper) AllValsetPasses(ctx sdk.Context, chainID string) []types.ValsetPass {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixValsetPass, []byte(chainID+"/")...))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	passes := []types.ValsetPass{}
+	for ; iterator.Valid(); iterator.Next() {
+		parts := strings.SplitN(string(iterator.Key()), "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		if len(passes) == 0 || passes[len(passes)-1].Status != parts[0] {
+			passes = append(passes, types.ValsetPass{ChainId: chainID, Status: parts[0]})
+		}
+		passes[len(passes)-1].Validators = append(passes[len(passes)-1].Validators, parts[1])
+	}
+	return passes
+}
+
+// EmitValsetPageQuery requests the page of the zone's validators of the given status starting at the given key.
+func (k Keeper) EmitValsetPageQuery(ctx sdk.Context, connectionID string, chainID string, status string, key []byte) error {
+	request := stakingtypes.QueryValidatorsRequest{Status: status, Pagination: &query.PageRequest{Key: key}}
+	bz, err := k.cdc.Marshal(&request)
+	if err != nil {
+		return err
+	}
+
+	k.ICQKeeper.MakeRequest(
+		ctx,
+		connectionID,
+		chainID,
+		"cosmos.staking.v1beta1.Query/Validators",
+		bz,
+		sdk.NewInt(-1),
+		types.ModuleName,
+		"valset",
+		0,
+	)
+	return nil
+}
@@ -7,14 +7,8 @@ import (
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
 
-const (
-	// setting WithdrawStatusTokenize as 0 causes the value to be omitted when (un)marshalling :/
-	WithdrawStatusTokenize int32 = iota + 1
-	WithdrawStatusSend     int32 = iota + 1
-)
-
-func (k Keeper) AddWithdrawalRecord(ctx sdk.Context, delegator string, validator string, recipient string, amount sdk.Coin, burnAmount sdk.Coin, hash string) {
-	record := &types.WithdrawalRecord{Delegator: delegator, Validator: validator, Recipient: recipient, Amount: amount, Status: WithdrawStatusTokenize, BurnAmount: burnAmount, Txhash: hash}
+func (k Keeper) AddWithdrawalRecord(ctx sdk.Context, delegator string, validator string, recipient string, amount sdk.Coin, burnAmount sdk.Coin, hash string, status types.WithdrawalRecordStatus) {
+	record := &types.WithdrawalRecord{Delegator: delegator, Validator: validator, Recipient: recipient, Amount: amount, Status: status, BurnAmount: burnAmount, Txhash: hash}
 	k.SetWithdrawalRecord(ctx, record)
 }
 
@@ -70,7 +64,7 @@ func (k Keeper) IterateWithdrawalRecords(ctx sdk.Context, delegator string, fn f
 
 // IterateWithdrawalRecords iterate through records for a given zone
 func (k Keeper) IterateWithdrawalRecordsWithTxhash(ctx sdk.Context, txhash string, delegator string, fn func(index int64, record types.WithdrawalRecord) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetWithdrawalKey(txhash, delegator))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GetWithdrawalKey(delegator, txhash))
 
 	iterator := sdk.KVStorePrefixIterator(store, nil)
 	defer iterator.Close()
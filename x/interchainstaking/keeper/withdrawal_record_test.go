@@ -0,0 +1,305 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/ingenuity-build/quicksilver/utils"
+	icstypes "github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func (s *KeeperTestSuite) TestWithdrawalRecordUnbonding() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+
+	delegator := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	recipient := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	validatorA := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	validatorB := "cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"
+	burnAmount := sdk.NewCoin("uqatom", sdk.NewInt(2000))
+
+	unbonding := func(validator string, hash string, batch string) {
+		app.InterchainstakingKeeper.SetWithdrawalRecord(ctx, &icstypes.WithdrawalRecord{
+			Delegator:      delegator,
+			Validator:      validator,
+			Recipient:      recipient,
+			Amount:         sdk.NewCoin("uatom", sdk.NewInt(1000)),
+			BurnAmount:     burnAmount,
+			Txhash:         hash,
+			Status:         icstypes.WithdrawStatusUnbond,
+			UnbondingBatch: batch,
+		})
+	}
+	unbonding(validatorA, "hash", "unbond/1")
+	unbonding(validatorB, "hash", "unbond/1")
+	unbonding(validatorA, "later", "unbond/2")
+
+	s.Require().Len(app.InterchainstakingKeeper.AllWithdrawalRecordsWithHash(ctx, "hash", delegator), 2)
+
+	// the acknowledgement completes only the records of its own batch, for its own validator.
+	completion := ctx.BlockTime().Add(21 * 24 * time.Hour)
+	msg := &stakingtypes.MsgUndelegate{DelegatorAddress: delegator, ValidatorAddress: validatorA, Amount: sdk.NewCoin("uatom", sdk.NewInt(1000))}
+	s.Require().NoError(app.InterchainstakingKeeper.HandleUndelegate(ctx, msg, completion, "unbond/1"))
+
+	recordA, found := app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, "hash", delegator, validatorA, recipient)
+	s.Require().True(found)
+	s.Require().True(recordA.CompletionTime.Equal(completion))
+
+	recordB, found := app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, "hash", delegator, validatorB, recipient)
+	s.Require().True(found)
+	s.Require().True(recordB.CompletionTime.IsZero())
+
+	later, found := app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, "later", delegator, validatorA, recipient)
+	s.Require().True(found)
+	s.Require().True(later.CompletionTime.IsZero())
+}
+
+func (s *KeeperTestSuite) TestHandleTimeoutRequeuesUnbonding() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+
+	app.InterchainstakingKeeper.SetRegisteredZone(ctx, icstypes.RegisteredZone{ChainId: s.chainB.ChainID, ConnectionId: s.path.EndpointA.ConnectionID, LocalDenom: "uqatom", BaseDenom: "uatom"})
+
+	delegator := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	recipient := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	amount := sdk.NewCoin("uatom", sdk.NewInt(1000))
+
+	app.InterchainstakingKeeper.SetWithdrawalRecord(ctx, &icstypes.WithdrawalRecord{Delegator: delegator, Validator: validator, Recipient: recipient, Amount: amount, BurnAmount: sdk.NewCoin("uqatom", sdk.NewInt(1000)), Txhash: "hash", Status: icstypes.WithdrawStatusUnbond, UnbondingBatch: "unbond/1"})
+
+	data, err := icatypes.SerializeCosmosTx(app.AppCodec(), []sdk.Msg{&stakingtypes.MsgUndelegate{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: amount}})
+	s.Require().NoError(err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data, Memo: "unbond/1"}
+	packet := channeltypes.Packet{Data: packetData.GetBytes()}
+
+	s.Require().NoError(app.InterchainstakingKeeper.HandleTimeout(ctx, packet))
+
+	record, found := app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, "hash", delegator, validator, recipient)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.WithdrawStatusQueued, record.Status)
+	s.Require().Empty(record.UnbondingBatch)
+}
+
+func (s *KeeperTestSuite) TestHandleTimeoutNeverFails() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	k := app.InterchainstakingKeeper
+
+	delegator := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	recipient := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	amount := sdk.NewCoin("uatom", sdk.NewInt(1000))
+	burnAmount := sdk.NewCoin("uqatom", sdk.NewInt(1000))
+
+	data, err := icatypes.SerializeCosmosTx(app.AppCodec(), []sdk.Msg{
+		&banktypes.MsgSend{FromAddress: delegator, ToAddress: recipient, Amount: sdk.NewCoins(amount)},
+		&stakingtypes.MsgUndelegate{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: amount},
+	})
+	s.Require().NoError(err)
+	packet := channeltypes.Packet{Data: icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data, Memo: "hash"}.GetBytes()}
+
+	// a timeout for a zone that has since been removed is accepted, so the packet is timed out and its channel closed.
+	s.Require().NoError(k.HandleTimeout(ctx, packet))
+
+	k.SetRegisteredZone(ctx, icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		DelegationAddresses: []*icstypes.ICAAccount{{Address: delegator}},
+	})
+	k.SetWithdrawalRecord(ctx, &icstypes.WithdrawalRecord{Delegator: delegator, Validator: validator, Recipient: recipient, Amount: amount, BurnAmount: burnAmount, Txhash: "hash", Status: icstypes.WithdrawStatusSend})
+	k.SetWithdrawalRecord(ctx, &icstypes.WithdrawalRecord{Delegator: delegator, Validator: validator, Recipient: "unbonding", Amount: amount, BurnAmount: burnAmount, Txhash: "hash", Status: icstypes.WithdrawStatusUnbond, UnbondingBatch: "hash"})
+	s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(burnAmount)))
+	k.SetRedemptionEscrow(ctx, icstypes.RedemptionEscrow{ChainId: s.chainB.ChainID, Txhash: "hash", Redeemer: "invalid", Amount: burnAmount, Refunded: sdk.NewCoin("uqatom", sdk.ZeroInt())})
+
+	// the refund of the failed payout cannot be paid, so its compensation is discarded; the undelegation is still
+	// requeued.
+	s.Require().NoError(k.HandleTimeout(ctx, packet))
+	record, found := k.GetWithdrawalRecord(ctx, "hash", delegator, validator, recipient)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.WithdrawStatusSend, record.Status)
+	record, found = k.GetWithdrawalRecord(ctx, "hash", delegator, validator, "unbonding")
+	s.Require().True(found)
+	s.Require().Equal(icstypes.WithdrawStatusQueued, record.Status)
+}
+
+func (s *KeeperTestSuite) TestWithdrawalRecordLifecycle() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	k := app.InterchainstakingKeeper
+
+	delegator := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	recipient := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	amount := sdk.NewCoin("uatom", sdk.NewInt(1000))
+	burnAmount := sdk.NewCoin("uqatom", sdk.NewInt(1000))
+
+	zone := icstypes.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		DepositAddress:      &icstypes.ICAAccount{Address: "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv"},
+		WithdrawalAddress:   &icstypes.ICAAccount{Address: "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a"},
+		DelegationAddresses: []*icstypes.ICAAccount{{Address: delegator, DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())}},
+	}
+	k.SetRegisteredZone(ctx, zone)
+
+	redeemer := sdk.AccAddress([]byte("redeemer____________"))
+	escrow := func(hash string) {
+		s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(burnAmount)))
+		k.SetRedemptionEscrow(ctx, icstypes.RedemptionEscrow{ChainId: zone.ChainId, Txhash: hash, Redeemer: redeemer.String(), Amount: burnAmount, Refunded: sdk.NewCoin("uqatom", sdk.ZeroInt())})
+	}
+	escrow("sent")
+	escrow("tokenized")
+
+	// an acknowledged send completes the withdrawal, and burns the escrowed qAssets.
+	k.AddWithdrawalRecord(ctx, delegator, validator, recipient, amount, burnAmount, "sent", icstypes.WithdrawStatusSend)
+	send := &banktypes.MsgSend{FromAddress: delegator, ToAddress: recipient, Amount: sdk.NewCoins(amount)}
+	s.Require().NoError(k.HandleCompleteSend(ctx, send, "sent"))
+
+	record, found := k.GetWithdrawalRecord(ctx, "sent", delegator, validator, recipient)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.WithdrawStatusCompleted, record.Status)
+	s.Require().True(record.CompletionTime.Equal(ctx.BlockTime()))
+	s.Require().Equal(burnAmount, app.BankKeeper.GetSupply(ctx, "uqatom"))
+	_, found = k.GetRedemptionEscrow(ctx, zone.ChainId, "sent")
+	s.Require().False(found)
+
+	// a tokenization that fails on every attempt fails the withdrawal.
+	k.AddWithdrawalRecord(ctx, delegator, validator, recipient, amount, burnAmount, "tokenized", icstypes.WithdrawStatusTokenize)
+	data, err := icatypes.SerializeCosmosTx(app.AppCodec(), []sdk.Msg{&stakingtypes.MsgTokenizeShares{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: amount, TokenizedShareOwner: recipient}})
+	s.Require().NoError(err)
+	k.SetIcaOperation(ctx, icstypes.IcaOperation{
+		ChainId:   s.chainB.ChainID,
+		PortId:    "icacontroller-test",
+		ChannelId: "channel-1",
+		Sequence:  1,
+		Memo:      "tokenized",
+		Data:      data,
+		Attempts:  icstypes.MaxIcaOperationAttempts,
+	})
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data, Memo: "tokenized"}
+	packet := channeltypes.Packet{SourcePort: "icacontroller-test", SourceChannel: "channel-1", Sequence: 1, Data: packetData.GetBytes()}
+	s.Require().NoError(k.HandleAcknowledgement(ctx, packet, channeltypes.NewErrorAcknowledgement("failed").Acknowledgement()))
+
+	record, found = k.GetWithdrawalRecord(ctx, "tokenized", delegator, validator, recipient)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.WithdrawStatusFailed, record.Status)
+
+	// the escrowed qAssets of the failed withdrawal are refunded to the redeemer.
+	s.Require().Equal(burnAmount, app.BankKeeper.GetBalance(ctx, redeemer, "uqatom"))
+	_, found = k.GetRedemptionEscrow(ctx, zone.ChainId, "tokenized")
+	s.Require().False(found)
+	s.Require().True(hasEvent(ctx, icstypes.EventTypeRedemptionRefund))
+
+	k.AddWithdrawalRecord(ctx, delegator, validator, "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a", amount, burnAmount, "queued", icstypes.WithdrawStatusQueued)
+
+	query := func(req icstypes.QueryWithdrawalRecordsRequest) []icstypes.WithdrawalRecord {
+		req.ChainId = s.chainB.ChainID
+		res, err := k.WithdrawalRecords(sdk.WrapSDKContext(ctx), &req)
+		s.Require().NoError(err)
+		return res.Withdrawals
+	}
+	s.Require().Len(query(icstypes.QueryWithdrawalRecordsRequest{}), 3)
+	s.Require().Len(query(icstypes.QueryWithdrawalRecordsRequest{Delegator: delegator}), 3)
+	s.Require().Len(query(icstypes.QueryWithdrawalRecordsRequest{Recipient: recipient}), 2)
+	failed := query(icstypes.QueryWithdrawalRecordsRequest{Status: icstypes.WithdrawStatusFailed})
+	s.Require().Len(failed, 1)
+	s.Require().Equal("tokenized", failed[0].Txhash)
+
+	_, err = k.WithdrawalRecords(sdk.WrapSDKContext(ctx), &icstypes.QueryWithdrawalRecordsRequest{ChainId: s.chainB.ChainID, Delegator: recipient})
+	s.Require().Error(err)
+	_, err = k.WithdrawalRecords(sdk.WrapSDKContext(ctx), &icstypes.QueryWithdrawalRecordsRequest{ChainId: "unknown-1"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestRedemptionEscrowSettlement() {
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), utils.ContextKey("connectionID"), s.path.EndpointA.ConnectionID))
+	k := app.InterchainstakingKeeper
+
+	delegatorA := "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e"
+	delegatorB := sdk.AccAddress([]byte("delegator___________")).String()
+	recipient := "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	amount := sdk.NewCoin("uatom", sdk.NewInt(1000))
+	burnAmount := sdk.NewCoin("uqatom", sdk.NewInt(2000))
+	redeemer := sdk.AccAddress([]byte("redeemer____________"))
+
+	zone := icstypes.RegisteredZone{
+		ChainId:           s.chainB.ChainID,
+		ConnectionId:      s.path.EndpointA.ConnectionID,
+		LocalDenom:        "uqatom",
+		BaseDenom:         "uatom",
+		DepositAddress:    &icstypes.ICAAccount{Address: "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv"},
+		WithdrawalAddress: &icstypes.ICAAccount{Address: "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a"},
+		DelegationAddresses: []*icstypes.ICAAccount{
+			{Address: delegatorA, DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())},
+			{Address: delegatorB, DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())},
+		},
+	}
+	k.SetRegisteredZone(ctx, zone)
+
+	redeem := func(hash string) {
+		s.Require().NoError(app.BankKeeper.MintCoins(ctx, icstypes.ModuleName, sdk.NewCoins(burnAmount)))
+		k.SetRedemptionEscrow(ctx, icstypes.RedemptionEscrow{ChainId: zone.ChainId, Txhash: hash, Redeemer: redeemer.String(), Amount: burnAmount, Refunded: sdk.NewCoin("uqatom", sdk.ZeroInt())})
+		for _, delegator := range []string{delegatorA, delegatorB} {
+			k.AddWithdrawalRecord(ctx, delegator, validator, recipient, amount, burnAmount, hash, icstypes.WithdrawStatusSend)
+		}
+	}
+	send := func(delegator string) *banktypes.MsgSend {
+		return &banktypes.MsgSend{FromAddress: delegator, ToAddress: recipient, Amount: sdk.NewCoins(amount)}
+	}
+
+	// a redemption paid out by several delegation accounts burns its escrow once, when the last payout completes.
+	redeem("completed")
+	s.Require().NoError(k.HandleCompleteSend(ctx, send(delegatorA), "completed"))
+	s.Require().Equal(burnAmount, app.BankKeeper.GetSupply(ctx, "uqatom"))
+	s.Require().NoError(k.HandleCompleteSend(ctx, send(delegatorB), "completed"))
+	s.Require().True(app.BankKeeper.GetSupply(ctx, "uqatom").IsZero())
+	_, found := k.GetRedemptionEscrow(ctx, zone.ChainId, "completed")
+	s.Require().False(found)
+
+	// a timed out payout refunds its share of the escrow; the remainder is burned once the other payout completes.
+	redeem("partial")
+	data, err := icatypes.SerializeCosmosTx(app.AppCodec(), []sdk.Msg{send(delegatorB)})
+	s.Require().NoError(err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data, Memo: "partial"}
+	s.Require().NoError(k.HandleTimeout(ctx, channeltypes.Packet{Data: packetData.GetBytes()}))
+
+	record, found := k.GetWithdrawalRecord(ctx, "partial", delegatorB, validator, recipient)
+	s.Require().True(found)
+	s.Require().Equal(icstypes.WithdrawStatusFailed, record.Status)
+	s.Require().Equal(sdk.NewCoin("uqatom", sdk.NewInt(1000)), app.BankKeeper.GetBalance(ctx, redeemer, "uqatom"))
+	escrow, found := k.GetRedemptionEscrow(ctx, zone.ChainId, "partial")
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoin("uqatom", sdk.NewInt(1000)), escrow.Refunded)
+	s.Require().True(hasEvent(ctx, icstypes.EventTypeRedemptionRefund))
+
+	s.Require().NoError(k.HandleCompleteSend(ctx, send(delegatorA), "partial"))
+	s.Require().Equal(sdk.NewCoin("uqatom", sdk.NewInt(1000)), app.BankKeeper.GetSupply(ctx, "uqatom"))
+	s.Require().Equal(sdk.NewCoin("uqatom", sdk.NewInt(1000)), app.BankKeeper.GetBalance(ctx, redeemer, "uqatom"))
+	_, found = k.GetRedemptionEscrow(ctx, zone.ChainId, "partial")
+	s.Require().False(found)
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
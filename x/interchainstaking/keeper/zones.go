@@ -35,12 +35,102 @@ func (k Keeper) SetRegisteredZone(ctx sdk.Context, zone types.RegisteredZone) {
 	store.Set([]byte(zone.ChainId), bz)
 }
 
-// DeleteRegisteredZone delete zone info
+// DeleteRegisteredZone deletes zone info, along with the delegations, delegation plans, intents, receipts and
+// interchain queries held for the zone.
 func (k Keeper) DeleteRegisteredZone(ctx sdk.Context, chainID string) {
+	if zone, found := k.GetRegisteredZoneInfo(ctx, chainID); found {
+		k.purgeZoneState(ctx, &zone)
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZone)
 	store.Delete([]byte(chainID))
 }
 
+// purgeZoneState removes state held for the zone. NOTE: keys are collected and removed after iteration, as
+// deleting mid-iteration breaks the iterator.
+func (k Keeper) purgeZoneState(ctx sdk.Context, zone *types.RegisteredZone) {
+	for _, delegation := range k.GetAllDelegations(ctx, zone) {
+		if err := k.RemoveDelegation(ctx, zone, delegation); err != nil {
+			k.Logger(ctx).Error("unable to remove delegation", "zone", zone.ChainId, "delegation", delegation, "err", err)
+		}
+	}
+
+	planKeys := [][]byte{}
+	k.IterateAllDelegationPlans(ctx, zone, func(_ types.DelegationPlan, key []byte) bool {
+		planKeys = append(planKeys, key)
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range planKeys {
+		store.Delete(key)
+	}
+
+	for _, snapshot := range []bool{false, true} {
+		for _, intent := range k.AllIntents(ctx, *zone, snapshot) {
+			k.DeleteIntent(ctx, *zone, intent.Delegator, snapshot)
+		}
+	}
+
+	receiptKeys := []string{}
+	k.IterateReceipts(ctx, func(_ int64, receipt types.Receipt) bool {
+		if receipt.Zone != nil && receipt.Zone.ChainId == zone.ChainId {
+			receiptKeys = append(receiptKeys, GetReceiptKey(*zone, receipt.Txhash))
+		}
+		return false
+	})
+	for _, key := range receiptKeys {
+		k.DeleteReceipt(ctx, key)
+	}
+
+	for _, refund := range k.AllRefunds(ctx, zone.ChainId) {
+		k.DeleteRefund(ctx, zone.ChainId, refund.Txhash)
+	}
+
+	for _, incident := range k.AllSlashingIncidents(ctx, zone.ChainId) {
+		k.DeleteSlashingIncident(ctx, incident)
+	}
+
+	for _, operation := range k.AllIcaOperations(ctx, zone.ChainId) {
+		k.DeleteIcaOperation(ctx, operation)
+	}
+
+	for _, escrow := range k.AllRedemptionEscrows(ctx, zone.ChainId) {
+		k.DeleteRedemptionEscrow(ctx, zone.ChainId, escrow.Txhash)
+	}
+
+	for _, da := range zone.GetDelegationAccounts() {
+		for _, record := range k.AllWithdrawalRecords(ctx, da.Address) {
+			k.DeleteWithdrawalRecord(ctx, record.Txhash, record.Delegator, record.Validator, record.Recipient)
+		}
+	}
+
+	for _, status := range []string{stakingtypes.BondStatusBonded, stakingtypes.BondStatusUnbonding, stakingtypes.BondStatusUnbonded} {
+		k.ClearValsetPass(ctx, zone.ChainId, status)
+	}
+
+	ibcDeposits := []types.IBCDeposit{}
+	k.IterateIBCDeposits(ctx, func(_ int64, deposit types.IBCDeposit) bool {
+		if deposit.ChainId == zone.ChainId {
+			ibcDeposits = append(ibcDeposits, deposit)
+		}
+		return false
+	})
+	for _, deposit := range ibcDeposits {
+		k.DeleteIBCDeposit(ctx, deposit.ChannelId, deposit.Sequence)
+	}
+
+	queryIDs := []string{}
+	k.ICQKeeper.IterateQueries(ctx, func(_ int64, query icqtypes.Query) bool {
+		if query.ChainId == zone.ChainId {
+			queryIDs = append(queryIDs, query.Id)
+		}
+		return false
+	})
+	for _, id := range queryIDs {
+		k.ICQKeeper.DeleteQuery(ctx, id)
+		k.ICQKeeper.DeleteDatapoint(ctx, id)
+	}
+}
+
 // IterateRegisteredZones iterate through zones
 func (k Keeper) IterateRegisteredZones(ctx sdk.Context, fn func(index int64, zoneInfo types.RegisteredZone) (stop bool)) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZone)
@@ -170,7 +260,7 @@ func SetAccountBalanceForDenom(k Keeper, ctx sdk.Context, zone types.RegisteredZ
 				// should we reconcile here?
 				k.Logger(ctx).Info("Outstanding Withdrawal Claims", "count", len(claims))
 				for _, claim := range claims {
-					if claim.Status == WithdrawStatusTokenize {
+					if claim.Status == types.WithdrawStatusTokenize {
 						// if the claim has tokenize status AND then remove any coins in the balance that match that validator.
 						// so we don't try to re-delegate any recently redeemed tokens that haven't been sent yet.
 						if strings.HasPrefix(coin.Denom, claim.Validator) {
@@ -178,6 +268,13 @@ func SetAccountBalanceForDenom(k Keeper, ctx sdk.Context, zone types.RegisteredZ
 							coin = coin.Sub(claim.Amount)
 						}
 					}
+					if ((!zone.LiquidityModule || zone.Sunsetting) && claim.Status == types.WithdrawStatusSend) || (claim.Status == types.WithdrawStatusUnbond && !claim.CompletionTime.IsZero() && !claim.CompletionTime.After(ctx.BlockTime())) {
+						// unbonded tokens awaiting payout to the user must not be re-delegated.
+						if coin.Denom == claim.Amount.Denom {
+							k.Logger(ctx).Info("Ignoring unbonded amount this iteration", "amount", claim.Amount)
+							coin = coin.SubAmount(sdk.MinInt(coin.Amount, claim.Amount.Amount))
+						}
+					}
 				}
 			}
 		}
@@ -185,7 +282,8 @@ func SetAccountBalanceForDenom(k Keeper, ctx sdk.Context, zone types.RegisteredZ
 		icaAccount.Balance = icaAccount.Balance.Add(coin)
 		k.Logger(ctx).Info("Matched delegate address", "address", address, "wg", icaAccount.BalanceWaitgroup, "balance", icaAccount.Balance)
 
-		if zone.WithdrawalAddress.BalanceWaitgroup == 0 {
+		// unbonded balances of a sunsetting zone are held for redemption.
+		if zone.WithdrawalAddress.BalanceWaitgroup == 0 && !zone.Sunsetting {
 			if !icaAccount.Balance.Empty() {
 				k.Logger(ctx).Info("Delegate account balance is non-zero; delegating!", "to_delegate", icaAccount.Balance)
 				valPlan, err := types.DelegationPlanFromGlobalIntent(k.GetDelegationBinsMap(ctx, &zone), zone, coin, zone.GetAggregateIntentOrDefault())
@@ -196,6 +294,8 @@ func SetAccountBalanceForDenom(k Keeper, ctx sdk.Context, zone types.RegisteredZ
 				if err != nil {
 					return err
 				}
+				// the balance tracks undelegated tokens only; a failed delegation requeries the balance.
+				icaAccount.Balance = icaAccount.Balance.Sub(sdk.NewCoins(coin))
 			}
 		}
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
@@ -100,3 +101,43 @@ func TestDeltasAndIntents(t *testing.T) {
 		fmt.Println(i)
 	}
 }
+
+func (s *KeeperTestSuite) TestSetAccountBalanceForDenom() {
+	port, address := s.openICAChannel(s.chainB.ChainID + ".delegate.0")
+
+	app := s.GetQuicksilverApp(s.chainA)
+	ctx := s.chainA.GetContext()
+	k := app.InterchainstakingKeeper
+
+	validator := "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"
+	zone := types.RegisteredZone{
+		ChainId:             s.chainB.ChainID,
+		ConnectionId:        s.path.EndpointA.ConnectionID,
+		LocalDenom:          "uqatom",
+		BaseDenom:           "uatom",
+		AccountPrefix:       "cosmos",
+		RedemptionRate:      sdk.OneDec(),
+		WithdrawalAddress:   &types.ICAAccount{BalanceWaitgroup: 1},
+		DelegationAddresses: []*types.ICAAccount{{Address: address, PortName: port, DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())}},
+		Validators:          []*types.Validator{{ValoperAddress: validator, CommissionRate: sdk.ZeroDec(), VotingPower: sdk.NewInt(1000), Status: stakingtypes.BondStatusBonded}},
+	}
+	k.SetRegisteredZone(ctx, zone)
+
+	// the balance is held while rewards are being distributed to the delegation accounts.
+	s.Require().NoError(keeper.SetAccountBalanceForDenom(k, ctx, zone, address, sdk.NewCoin("uatom", sdk.NewInt(100))))
+	zone, found := k.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100))), zone.DelegationAddresses[0].Balance)
+	s.Require().Empty(k.AllIcaOperations(ctx, zone.ChainId))
+
+	// otherwise it is delegated, and is no longer undelegated balance of the account.
+	zone.WithdrawalAddress.BalanceWaitgroup = 0
+	k.SetRegisteredZone(ctx, zone)
+	s.Require().NoError(keeper.SetAccountBalanceForDenom(k, ctx, zone, address, sdk.NewCoin("uatom", sdk.NewInt(100))))
+	zone, found = k.GetRegisteredZoneInfo(ctx, zone.ChainId)
+	s.Require().True(found)
+	s.Require().True(zone.DelegationAddresses[0].Balance.Empty())
+	operations := k.AllIcaOperations(ctx, zone.ChainId)
+	s.Require().Len(operations, 1)
+	s.Require().Equal([]string{"/cosmos.staking.v1beta1.MsgDelegate"}, operations[0].MsgTypes)
+}
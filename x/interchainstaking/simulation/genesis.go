@@ -0,0 +1,269 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// Simulation parameter constants
+const (
+	DelegateAccountCount = "delegate_account_count"
+	DelegateAccountSplit = "delegate_account_split"
+	DepositInterval      = "deposit_interval"
+	DelegationsInterval  = "delegations_interval"
+	ValidatorSetInterval = "validatorset_interval"
+	CommissionRate       = "commission_rate"
+	MaxMsgsPerTx         = "max_msgs_per_tx"
+	Zones                = "zones"
+
+	// HostAccountPrefix is the bech32 prefix of simulated host chain accounts.
+	HostAccountPrefix = "cosmos"
+)
+
+// RandomizedGenState generates a random GenesisState for interchainstaking. Each simulated zone has a validator
+// set, delegations from its delegation accounts and intents of simulation accounts; qAssets backing the delegations
+// at the zone's redemption rate are added to the bank genesis.
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		delegateAccountCount uint64
+		delegateAccountSplit uint64
+		depositInterval      uint64
+		delegationsInterval  uint64
+		validatorSetInterval uint64
+		commissionRate       sdk.Dec
+		maxMsgsPerTx         uint64
+		zoneCount            int
+	)
+
+	simState.AppParams.GetOrGenerate(simState.Cdc, DelegateAccountCount, &delegateAccountCount, simState.Rand,
+		func(r *rand.Rand) { delegateAccountCount = uint64(simtypes.RandIntBetween(r, 1, 11)) },
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, DelegateAccountSplit, &delegateAccountSplit, simState.Rand,
+		func(r *rand.Rand) {
+			delegateAccountSplit = uint64(simtypes.RandIntBetween(r, 1, int(delegateAccountCount)+1))
+		},
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, DepositInterval, &depositInterval, simState.Rand,
+		func(r *rand.Rand) { depositInterval = uint64(simtypes.RandIntBetween(r, 5, 100)) },
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, DelegationsInterval, &delegationsInterval, simState.Rand,
+		func(r *rand.Rand) { delegationsInterval = uint64(simtypes.RandIntBetween(r, 5, 200)) },
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, ValidatorSetInterval, &validatorSetInterval, simState.Rand,
+		func(r *rand.Rand) { validatorSetInterval = uint64(simtypes.RandIntBetween(r, 5, 200)) },
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, CommissionRate, &commissionRate, simState.Rand,
+		func(r *rand.Rand) { commissionRate = sdk.NewDecWithPrec(int64(r.Intn(11)), 2) },
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, MaxMsgsPerTx, &maxMsgsPerTx, simState.Rand,
+		func(r *rand.Rand) { maxMsgsPerTx = uint64(simtypes.RandIntBetween(r, 1, 41)) },
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, Zones, &zoneCount, simState.Rand,
+		func(r *rand.Rand) { zoneCount = simtypes.RandIntBetween(r, 1, 4) },
+	)
+
+	params := types.NewParams(
+		delegateAccountCount,
+		delegateAccountSplit,
+		depositInterval,
+		types.DefaultDelegateInterval,
+		delegationsInterval,
+		validatorSetInterval,
+		commissionRate,
+		types.DefaultCommunityPoolShare,
+		types.DefaultTreasuryShare,
+		types.DefaultTreasuryAddress,
+		maxMsgsPerTx,
+	)
+
+	genesis := types.GenesisState{Params: params}
+	qAssets := map[string]sdk.Coins{}
+	for i := 1; i <= zoneCount; i++ {
+		randomZone(simState, &genesis, i, qAssets)
+	}
+
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated interchainstaking parameters:\n%s\n", bz)
+	fmt.Printf("Selected %d randomly generated interchainstaking zones\n", zoneCount)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+	addBankBalances(simState, qAssets)
+}
+
+// randomZone adds a zone with a random validator set and random delegations to the genesis. qAssets minted against
+// the delegations are credited to a random subset of simulation accounts in qAssets.
+func randomZone(simState *module.SimulationState, genesis *types.GenesisState, index int, qAssets map[string]sdk.Coins) {
+	r := simState.Rand
+	chainID := fmt.Sprintf("simzone-%d", index)
+
+	zone := types.RegisteredZone{
+		ConnectionId:            fmt.Sprintf("connection-%d", index-1),
+		ChainId:                 chainID,
+		AccountPrefix:           HostAccountPrefix,
+		LocalDenom:              fmt.Sprintf("uqsim%d", index),
+		BaseDenom:               fmt.Sprintf("usim%d", index),
+		RedemptionRate:          sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(200)), 3)),
+		CommissionRate:          genesis.Params.CommissionRate,
+		MaxRedemptionRateChange: sdk.ZeroDec(),
+		MinRedemptionRate:       sdk.ZeroDec(),
+		MaxRedemptionRate:       sdk.ZeroDec(),
+		RewardsDust:             sdk.ZeroInt(),
+	}
+	zone.LastRedemptionRate = zone.RedemptionRate
+
+	zone.DepositAddress = randomICAAccount(r, zone, chainID+".deposit")
+	zone.WithdrawalAddress = randomICAAccount(r, zone, chainID+".withdrawal")
+	zone.PerformanceAddress = randomICAAccount(r, zone, chainID+".performance")
+	accountCount := simtypes.RandIntBetween(r, 1, 5)
+	for i := 0; i < accountCount; i++ {
+		zone.DelegationAddresses = append(zone.DelegationAddresses, randomICAAccount(r, zone, fmt.Sprintf("%s.delegate.%d", chainID, i)))
+	}
+	zone.DelegationAccountCount = uint32(accountCount)
+
+	validatorCount := simtypes.RandIntBetween(r, 3, 9)
+	for i := 0; i < validatorCount; i++ {
+		zone.Validators = append(zone.Validators, &types.Validator{
+			ValoperAddress:   randomAddress(r, HostAccountPrefix+"valoper", 20),
+			ConsensusAddress: randomAddress(r, HostAccountPrefix+"valcons", 20),
+			CommissionRate:   sdk.NewDecWithPrec(int64(r.Intn(21)), 2),
+			DelegatorShares:  sdk.ZeroDec(),
+			VotingPower:      sdk.ZeroInt(),
+			Score:            sdk.ZeroDec(),
+			Status:           stakingtypes.BondStatusBonded,
+		})
+	}
+
+	// holders of the zone's qAssets; the delegations back the qAsset supply at the redemption rate.
+	supply := sdk.ZeroInt()
+	intents := []*types.DelegatorIntent{}
+	for _, account := range simState.Accounts {
+		if r.Intn(2) == 0 {
+			continue
+		}
+		amount := sdk.NewInt(int64(simtypes.RandIntBetween(r, 1_000_000, 1_000_000_000)))
+		supply = supply.Add(amount)
+		qAssets[account.Address.String()] = qAssets[account.Address.String()].Add(sdk.NewCoin(zone.LocalDenom, amount))
+
+		if r.Intn(2) == 0 {
+			intents = append(intents, &types.DelegatorIntent{Delegator: account.Address.String(), Intents: RandomIntents(r, zone.Validators)})
+		}
+	}
+
+	delegations := []*types.Delegation{}
+	remaining := supply.ToDec().Mul(zone.RedemptionRate).TruncateInt()
+	for i, account := range zone.DelegationAddresses {
+		for j, validator := range zone.Validators {
+			amount := remaining
+			if i < len(zone.DelegationAddresses)-1 || j < len(zone.Validators)-1 {
+				amount = remaining.QuoRaw(int64(simtypes.RandIntBetween(r, 2, 2*len(zone.Validators))))
+			}
+			if !amount.IsPositive() {
+				continue
+			}
+			remaining = remaining.Sub(amount)
+
+			delegation := types.NewDelegation(account.Address, validator.ValoperAddress, sdk.NewCoin(zone.BaseDenom, amount))
+			delegations = append(delegations, &delegation)
+			account.DelegatedBalance = account.DelegatedBalance.Add(delegation.Amount)
+			validator.VotingPower = validator.VotingPower.Add(amount)
+		}
+	}
+
+	// delegations from other delegators on the host chain.
+	for _, validator := range zone.Validators {
+		validator.VotingPower = validator.VotingPower.Add(sdk.NewInt(int64(simtypes.RandIntBetween(r, 1_000_000, 1_000_000_000_000))))
+		validator.DelegatorShares = validator.VotingPower.ToDec()
+	}
+
+	genesis.Zones = append(genesis.Zones, zone)
+	genesis.Delegations = append(genesis.Delegations, types.DelegationsForZone{ChainId: chainID, Delegations: delegations})
+	genesis.DelegatorIntents = append(genesis.DelegatorIntents, types.DelegatorIntentsForZone{ChainId: chainID, DelegationIntent: intents})
+	for _, account := range append([]*types.ICAAccount{zone.DepositAddress, zone.WithdrawalAddress, zone.PerformanceAddress}, zone.DelegationAddresses...) {
+		genesis.PortConnections = append(genesis.PortConnections, types.PortConnectionTuple{ConnectionId: zone.ConnectionId, PortId: account.PortName})
+	}
+}
+
+// RandomIntents returns intents for between one and three random validators, with weights summing to one.
+func RandomIntents(r *rand.Rand, validators []*types.Validator) []*types.ValidatorIntent {
+	count := simtypes.RandIntBetween(r, 1, 4)
+	if count > len(validators) {
+		count = len(validators)
+	}
+
+	intents := make([]*types.ValidatorIntent, 0, count)
+	remaining := int64(100)
+	for i, idx := range r.Perm(len(validators))[:count] {
+		weight := remaining
+		if i < count-1 {
+			weight = int64(simtypes.RandIntBetween(r, 1, int(remaining)-(count-i-1)+1))
+		}
+		remaining -= weight
+		intents = append(intents, &types.ValidatorIntent{ValoperAddress: validators[idx].ValoperAddress, Weight: sdk.NewDecWithPrec(weight, 2)})
+	}
+
+	return intents
+}
+
+func randomICAAccount(r *rand.Rand, zone types.RegisteredZone, portOwner string) *types.ICAAccount {
+	portID, err := icatypes.NewControllerPortID(portOwner)
+	if err != nil {
+		panic(err)
+	}
+	return &types.ICAAccount{
+		Address:          randomAddress(r, zone.AccountPrefix, 32),
+		DelegatedBalance: sdk.NewCoin(zone.BaseDenom, sdk.ZeroInt()),
+		PortName:         portID,
+	}
+}
+
+func randomAddress(r *rand.Rand, prefix string, length int) string {
+	bz := make([]byte, length)
+	r.Read(bz)
+	address, err := bech32.ConvertAndEncode(prefix, bz)
+	if err != nil {
+		panic(err)
+	}
+	return address
+}
+
+// addBankBalances credits the given qAssets to the bank genesis, increasing the supply to match if it is set.
+func addBankBalances(simState *module.SimulationState, qAssets map[string]sdk.Coins) {
+	bankGenesis := banktypes.GenesisState{}
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], &bankGenesis)
+
+	total := sdk.NewCoins()
+	for i, balance := range bankGenesis.Balances {
+		if coins, ok := qAssets[balance.Address]; ok {
+			bankGenesis.Balances[i].Coins = balance.Coins.Add(coins...)
+			total = total.Add(coins...)
+			delete(qAssets, balance.Address)
+		}
+	}
+	for _, account := range simState.Accounts {
+		if coins, ok := qAssets[account.Address.String()]; ok {
+			bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{Address: account.Address.String(), Coins: coins})
+			total = total.Add(coins...)
+		}
+	}
+	if !bankGenesis.Supply.Empty() {
+		bankGenesis.Supply = bankGenesis.Supply.Add(total...)
+	}
+
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
+}
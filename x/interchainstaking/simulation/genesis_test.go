@@ -0,0 +1,75 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banksimulation "github.com/cosmos/cosmos-sdk/x/bank/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/simulation"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// TestRandomizedGenState checks that the delegations of each simulated zone are consistent with its delegation
+// accounts, and back the qAssets credited to the bank genesis at the zone's redemption rate.
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	r := rand.New(rand.NewSource(1))
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 10),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+	banksimulation.RandomizedGenState(&simState)
+	simulation.RandomizedGenState(&simState)
+
+	var genesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+	require.NoError(t, genesis.Validate())
+	require.NotEmpty(t, genesis.Zones)
+	require.Len(t, genesis.Delegations, len(genesis.Zones))
+
+	var bankGenesis banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], &bankGenesis)
+	balances := sdk.NewCoins()
+	for _, balance := range bankGenesis.Balances {
+		balances = balances.Add(balance.Coins...)
+	}
+
+	for i, zone := range genesis.Zones {
+		delegated := map[string]sdk.Int{}
+		for _, delegation := range genesis.Delegations[i].Delegations {
+			amount, ok := delegated[delegation.DelegationAddress]
+			if !ok {
+				amount = sdk.ZeroInt()
+			}
+			delegated[delegation.DelegationAddress] = amount.Add(delegation.Amount.Amount)
+		}
+		for _, account := range zone.DelegationAddresses {
+			if amount, ok := delegated[account.Address]; ok {
+				require.Equal(t, amount, account.DelegatedBalance.Amount)
+			} else {
+				require.True(t, account.DelegatedBalance.IsZero())
+			}
+		}
+
+		supply := bankGenesis.Supply.AmountOf(zone.LocalDenom)
+		require.True(t, supply.IsPositive())
+		require.Equal(t, balances.AmountOf(zone.LocalDenom), supply)
+		require.Equal(t, supply.ToDec().Mul(zone.RedemptionRate).TruncateInt(), zone.GetDelegatedAmount().Amount)
+	}
+}
@@ -0,0 +1,118 @@
+package simulation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	icqsimulation "github.com/ingenuity-build/quicksilver/x/interchainquery/simulation"
+	icqtypes "github.com/ingenuity-build/quicksilver/x/interchainquery/types"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// HostResponder returns a QueryResponder that answers the interchain queries of registered zones as a host chain
+// would, if the host chain's state were exactly that recorded by the zone. Validators and delegations are served
+// from the zone's records, no rewards accrue, and no deposit transactions are found; deposits are instead relayed
+// by SimulateHostDeposit. Queries requiring proofs are not answered.
+func HostResponder(k keeper.Keeper) icqsimulation.QueryResponder {
+	return func(ctx sdk.Context, query icqtypes.Query) ([]byte, int64, bool) {
+		zone, found := k.GetRegisteredZoneInfo(ctx, query.ChainId)
+		if !found {
+			return nil, 0, false
+		}
+
+		var response interface {
+			Marshal() ([]byte, error)
+		}
+		switch query.QueryType {
+		case "cosmos.staking.v1beta1.Query/Validators":
+			request := stakingtypes.QueryValidatorsRequest{}
+			if err := request.Unmarshal(query.Request); err != nil {
+				return nil, 0, false
+			}
+			response = &stakingtypes.QueryValidatorsResponse{Validators: hostValidators(zone, request.Status)}
+
+		case "cosmos.staking.v1beta1.Query/DelegatorDelegations":
+			request := stakingtypes.QueryDelegatorDelegationsRequest{}
+			if err := request.Unmarshal(query.Request); err != nil {
+				return nil, 0, false
+			}
+			response = &stakingtypes.QueryDelegatorDelegationsResponse{DelegationResponses: hostDelegations(ctx, k, zone, request.DelegatorAddr)}
+
+		case "cosmos.distribution.v1beta1.Query/DelegationTotalRewards":
+			response = &distrtypes.QueryDelegationTotalRewardsResponse{}
+
+		case "cosmos.bank.v1beta1.Query/AllBalances":
+			request := banktypes.QueryAllBalancesRequest{}
+			if err := request.Unmarshal(query.Request); err != nil {
+				return nil, 0, false
+			}
+			response = &banktypes.QueryAllBalancesResponse{Balances: hostBalances(zone, request.Address)}
+
+		case "cosmos.tx.v1beta1.Service/GetTxsEvent":
+			response = &tx.GetTxsEventResponse{}
+
+		default:
+			return nil, 0, false
+		}
+
+		bz, err := response.Marshal()
+		if err != nil {
+			return nil, 0, false
+		}
+		return bz, ctx.BlockHeight(), true
+	}
+}
+
+// hostValidators returns the zone's validators of the given bond status, or all validators if status is empty.
+func hostValidators(zone types.RegisteredZone, status string) []stakingtypes.Validator {
+	validators := []stakingtypes.Validator{}
+	for _, validator := range zone.Validators {
+		if status != "" && validator.Status != status {
+			continue
+		}
+		validators = append(validators, stakingtypes.Validator{
+			OperatorAddress: validator.ValoperAddress,
+			Jailed:          validator.Jailed,
+			Status:          stakingtypes.BondStatus(stakingtypes.BondStatus_value[validator.Status]),
+			Tokens:          validator.VotingPower,
+			DelegatorShares: validator.DelegatorShares,
+			Commission:      stakingtypes.NewCommission(validator.CommissionRate, sdk.OneDec(), sdk.OneDec()),
+		})
+	}
+	return validators
+}
+
+// hostBalances returns the recorded balance of the zone's interchain account with the given address.
+func hostBalances(zone types.RegisteredZone, address string) sdk.Coins {
+	for _, account := range append([]*types.ICAAccount{zone.DepositAddress, zone.WithdrawalAddress, zone.PerformanceAddress}, zone.DelegationAddresses...) {
+		if account != nil && account.Address == address {
+			return account.Balance
+		}
+	}
+	return sdk.NewCoins()
+}
+
+// hostDelegations returns the zone's delegation records of the given delegator.
+func hostDelegations(ctx sdk.Context, k keeper.Keeper, zone types.RegisteredZone, delegator string) []stakingtypes.DelegationResponse {
+	responses := []stakingtypes.DelegationResponse{}
+	_, delAddr, err := bech32.DecodeAndConvert(delegator)
+	if err != nil {
+		return responses
+	}
+	for _, delegation := range k.GetDelegatorDelegations(ctx, &zone, delAddr) {
+		responses = append(responses, stakingtypes.DelegationResponse{
+			Delegation: stakingtypes.Delegation{
+				DelegatorAddress: delegation.DelegationAddress,
+				ValidatorAddress: delegation.ValidatorAddress,
+				Shares:           delegation.Amount.Amount.ToDec(),
+			},
+			Balance: delegation.Amount,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,200 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	icqsimulation "github.com/ingenuity-build/quicksilver/x/interchainquery/simulation"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/keeper"
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSignalIntent             = "op_weight_msg_signal_intent"
+	OpWeightMsgRequestRedemption        = "op_weight_msg_request_redemption"
+	OpWeightMsgSubmitQueryResponse      = "op_weight_msg_submit_query_response"
+	OpWeightHostDeposit                 = "op_weight_host_deposit"
+	DefaultWeightMsgSignalIntent        = 50
+	DefaultWeightMsgRequestRedemption   = 30
+	DefaultWeightMsgSubmitQueryResponse = 100
+	DefaultWeightHostDeposit            = 50
+
+	// TypeHostDeposit is the operation type of simulated host chain deposits.
+	TypeHostDeposit = "hostdeposit"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights. Query responses for
+// the module's interchain queries are served by HostResponder.
+func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, k keeper.Keeper) simulation.WeightedOperations {
+	var weightMsgSignalIntent, weightMsgRequestRedemption, weightMsgSubmitQueryResponse, weightHostDeposit int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSignalIntent, &weightMsgSignalIntent, nil,
+		func(_ *rand.Rand) { weightMsgSignalIntent = DefaultWeightMsgSignalIntent },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRequestRedemption, &weightMsgRequestRedemption, nil,
+		func(_ *rand.Rand) { weightMsgRequestRedemption = DefaultWeightMsgRequestRedemption },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitQueryResponse, &weightMsgSubmitQueryResponse, nil,
+		func(_ *rand.Rand) { weightMsgSubmitQueryResponse = DefaultWeightMsgSubmitQueryResponse },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightHostDeposit, &weightHostDeposit, nil,
+		func(_ *rand.Rand) { weightHostDeposit = DefaultWeightHostDeposit },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgSignalIntent, SimulateMsgSignalIntent(k)),
+		simulation.NewWeightedOperation(weightMsgRequestRedemption, SimulateMsgRequestRedemption(k)),
+		simulation.NewWeightedOperation(weightMsgSubmitQueryResponse, icqsimulation.SimulateMsgSubmitQueryResponse(k.AccountKeeper, k.BankKeeper, k.ICQKeeper, HostResponder(k))),
+		simulation.NewWeightedOperation(weightHostDeposit, SimulateHostDeposit(k)),
+	}
+}
+
+// SimulateMsgSignalIntent signals random intents for a random zone.
+func SimulateMsgSignalIntent(k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		zone, ok := selectZone(r, k.AllRegisteredZones(ctx), func(zone types.RegisteredZone) bool { return !zone.IsPaused() })
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSignalIntent, "no active zones"), nil, nil
+		}
+
+		validators := []*types.Validator{}
+		for _, validator := range zone.Validators {
+			if !zone.IsValidatorExcluded(validator.ValoperAddress) {
+				validators = append(validators, validator)
+			}
+		}
+		if len(validators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSignalIntent, "no eligible validators"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgSignalIntent(zone.ChainId, RandomIntents(r, validators), simAccount.Address)
+
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, k, simAccount, msg, sdk.NewCoins()))
+	}
+}
+
+// SimulateMsgRequestRedemption redeems a random amount of a random account's qAssets.
+func SimulateMsgRequestRedemption(k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		spendable := k.BankKeeper.SpendableCoins(ctx, simAccount.Address)
+
+		zone, ok := selectZone(r, k.AllRegisteredZones(ctx), func(zone types.RegisteredZone) bool {
+			return zone.RedemptionsEnabled() && !zone.CircuitBreakerTripped && !zone.Sunsetting &&
+				zone.GetDelegatedAmount().IsPositive() && spendable.AmountOf(zone.LocalDenom).IsPositive()
+		})
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestRedemption, "no redeemable qAssets"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, spendable.AmountOf(zone.LocalDenom))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestRedemption, err.Error()), nil, nil
+		}
+		coin := sdk.NewCoin(zone.LocalDenom, amount)
+
+		destination, err := bech32.ConvertAndEncode(zone.AccountPrefix, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestRedemption, err.Error()), nil, err
+		}
+		msg := types.NewMsgRequestRedemption(coin.String(), destination, simAccount.Address)
+
+		return simulation.GenAndDeliverTxWithRandFees(operationInput(r, app, ctx, k, simAccount, msg, sdk.NewCoins(coin)))
+	}
+}
+
+// SimulateHostDeposit simulates a random account depositing to a zone's deposit address on the host chain, and the
+// deposit transaction being relayed back. Deposit transactions are relayed with a proof of inclusion that cannot be
+// produced in simulation, so the receipt is handled as though the proof had been verified.
+func SimulateHostDeposit(k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		zone, ok := selectZone(r, k.AllRegisteredZones(ctx), func(zone types.RegisteredZone) bool {
+			return zone.DepositsEnabled() && !zone.CircuitBreakerTripped && !zone.Sunsetting && zone.DepositAddress != nil
+		})
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeHostDeposit, "no zones accepting deposits"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		sender, err := bech32.ConvertAndEncode(zone.AccountPrefix, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeHostDeposit, err.Error()), nil, err
+		}
+		amount := sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, sdk.NewInt(int64(simtypes.RandIntBetween(r, 1_000, 1_000_000_000)))))
+
+		hash := make([]byte, 32)
+		r.Read(hash)
+		txr := &sdk.TxResponse{
+			TxHash: strings.ToUpper(hex.EncodeToString(hash)),
+			Height: ctx.BlockHeight(),
+			Events: []abci.Event{
+				{
+					Type: "transfer",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("recipient"), Value: []byte(zone.DepositAddress.Address)},
+						{Key: []byte("sender"), Value: []byte(sender)},
+						{Key: []byte("amount"), Value: []byte(amount.String())},
+					},
+				},
+			},
+		}
+
+		k.HandleReceiptTransaction(ctx, txr, &tx.Tx{Body: &tx.TxBody{}}, zone)
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, TypeHostDeposit, "", true, nil), nil, nil
+	}
+}
+
+// selectZone returns a random zone satisfying the filter.
+func selectZone(r *rand.Rand, zones []types.RegisteredZone, filter func(types.RegisteredZone) bool) (types.RegisteredZone, bool) {
+	candidates := []types.RegisteredZone{}
+	for _, zone := range zones {
+		if filter(zone) {
+			candidates = append(candidates, zone)
+		}
+	}
+	if len(candidates) == 0 {
+		return types.RegisteredZone{}, false
+	}
+	return candidates[r.Intn(len(candidates))], true
+}
+
+func operationInput(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, k keeper.Keeper, simAccount simtypes.Account, msg legacyMsg, spent sdk.Coins) simulation.OperationInput {
+	return simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   k.AccountKeeper,
+		Bankkeeper:      k.BankKeeper,
+		ModuleName:      types.ModuleName,
+	}
+}
+
+type legacyMsg interface {
+	sdk.Msg
+	Type() string
+}
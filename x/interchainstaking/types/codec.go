@@ -18,6 +18,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSignalIntent{}, "cosmos-sdk/MsgSignalIntent", nil)
 	cdc.RegisterConcrete(&RegisterZoneProposal{}, "cosmos-sdk/RegisterZoneProposal", nil)
 	cdc.RegisterConcrete(&UpdateZoneProposal{}, "cosmos-sdk/UpdateZoneProposal", nil)
+	cdc.RegisterConcrete(&DeregisterZoneProposal{}, "cosmos-sdk/DeregisterZoneProposal", nil)
+	cdc.RegisterConcrete(&ResumeZoneProposal{}, "cosmos-sdk/ResumeZoneProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -31,6 +33,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*govtypes.Content)(nil),
 		&UpdateZoneProposal{},
 		&RegisterZoneProposal{},
+		&DeregisterZoneProposal{},
+		&ResumeZoneProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -43,4 +47,10 @@ func init() {
 
 	govtypes.RegisterProposalType(ProposalTypeUpdateZone)
 	govtypes.RegisterProposalTypeCodec(&UpdateZoneProposal{}, "cosmos-sdk/UpdateZoneProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeDeregisterZone)
+	govtypes.RegisterProposalTypeCodec(&DeregisterZoneProposal{}, "cosmos-sdk/DeregisterZoneProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeResumeZone)
+	govtypes.RegisterProposalTypeCodec(&ResumeZoneProposal{}, "cosmos-sdk/ResumeZoneProposal")
 }
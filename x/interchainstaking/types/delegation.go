@@ -313,6 +313,10 @@ func DelegationPlanFromGlobalIntent(currentState Allocations, zone RegisteredZon
 		return nil, fmt.Errorf("expected base denom, got %s", coin.Denom)
 	}
 
+	if len(intent) == 0 {
+		return nil, fmt.Errorf("no eligible validators for zone %s", zone.ChainId)
+	}
+
 	allocations := Allocations{}
 
 	// fetch current state
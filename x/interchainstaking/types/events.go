@@ -1,8 +1,22 @@
 package types
 
 const (
-	EventTypeRegisterZone      = "register_zone"
-	EventTypeRedemptionRequest = "request_redemption"
+	EventTypeRegisterZone       = "register_zone"
+	EventTypeDeregisterZone     = "deregister_zone"
+	EventTypeRedemptionRequest  = "request_redemption"
+	EventTypeIcaTimeout         = "ica_timeout"
+	EventTypeIcaFailure         = "ica_failure"
+	EventTypeIcaChannelClosed   = "ica_channel_closed"
+	EventTypeIcaChannelReopened = "ica_channel_reopened"
+	EventTypeCircuitBreaker     = "redemption_rate_circuit_breaker"
+	EventTypeResumeZone         = "resume_zone"
+	EventTypeZoneStateChange    = "zone_state_change"
+	EventTypeRefund             = "refund"
+	EventTypeIBCDepositRefund   = "ibc_deposit_refund"
+	EventTypeMemoParseError     = "memo_parse_error"
+	EventTypeDepositReferral    = "deposit_referral"
+	EventTypeValidatorSlashed   = "validator_slashed"
+	EventTypeRedemptionRefund   = "redemption_refund"
 
 	AttributeKeyConnectionID     = "connection_id"
 	AttributeKeyRecipientChain   = "chain_id"
@@ -10,6 +24,24 @@ const (
 	AttributeKeyBurnAmount       = "burn_amount"
 	AttributeKeyRedeemAmount     = "redeem_amount"
 	AttributeKeySourceAddress    = "source"
+	AttributeKeyMsgType          = "msg_type"
+	AttributeKeyTimeoutOutcome   = "outcome"
+	AttributeKeyPortID           = "port_id"
+	AttributeKeyRedemptionRate   = "redemption_rate"
+	AttributeKeyProposedRate     = "proposed_rate"
+	AttributeKeyReason           = "reason"
+	AttributeKeyState            = "state"
+	AttributeKeyPreviousState    = "previous_state"
+	AttributeKeyTxHash           = "txhash"
+	AttributeKeyReferral         = "referral"
+	AttributeKeyValidator        = "validator"
+	AttributeKeySlashFraction    = "slash_fraction"
+	AttributeKeyRefundAmount     = "refund_amount"
+
+	AttributeValueTimeoutRequeued             = "requeued"
+	AttributeValueTimeoutRetained             = "retained"
+	AttributeValueTimeoutWaitgroupDecremented = "waitgroup_decremented"
+	AttributeValueTimeoutRefunded             = "refunded"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 func NewGenesisState(params Params, zones []RegisteredZone) *GenesisState {
 	return &GenesisState{Params: params, Zones: zones}
 }
@@ -13,6 +15,72 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// TODO: validate genesis state.
-	return validateParams(gs.Params)
+	if err := validateParams(gs.Params); err != nil {
+		return err
+	}
+
+	zones := make(map[string]bool, len(gs.Zones))
+	delegators := make(map[string]bool)
+	for _, zone := range gs.Zones {
+		if zones[zone.ChainId] {
+			return fmt.Errorf("duplicate zone %s", zone.ChainId)
+		}
+		zones[zone.ChainId] = true
+		for _, account := range zone.DelegationAddresses {
+			delegators[account.Address] = true
+		}
+	}
+
+	// every zone scoped entry must refer to a zone in the genesis state.
+	chainIDs := []string{}
+	for _, delegations := range gs.Delegations {
+		chainIDs = append(chainIDs, delegations.ChainId)
+	}
+	for _, plans := range gs.DelegationPlans {
+		chainIDs = append(chainIDs, plans.ChainId)
+	}
+	for _, intents := range gs.DelegatorIntents {
+		chainIDs = append(chainIDs, intents.ChainId)
+	}
+	for _, refund := range gs.Refunds {
+		chainIDs = append(chainIDs, refund.ChainId)
+	}
+	for _, deposit := range gs.IbcDeposits {
+		chainIDs = append(chainIDs, deposit.ChainId)
+	}
+	for _, incident := range gs.SlashingIncidents {
+		chainIDs = append(chainIDs, incident.ChainId)
+	}
+	for _, operation := range gs.IcaOperations {
+		chainIDs = append(chainIDs, operation.ChainId)
+	}
+	for _, fees := range gs.ZoneFees {
+		chainIDs = append(chainIDs, fees.ChainId)
+	}
+	for _, escrow := range gs.RedemptionEscrows {
+		chainIDs = append(chainIDs, escrow.ChainId)
+	}
+	for _, pass := range gs.ValsetPasses {
+		chainIDs = append(chainIDs, pass.ChainId)
+	}
+	for _, receipt := range gs.Receipts {
+		if receipt.Zone == nil {
+			return fmt.Errorf("no zone for receipt %s", receipt.Txhash)
+		}
+		chainIDs = append(chainIDs, receipt.Zone.ChainId)
+	}
+	for _, chainID := range chainIDs {
+		if !zones[chainID] {
+			return fmt.Errorf("no zone for chain id %s", chainID)
+		}
+	}
+
+	// withdrawal records are keyed by delegator, so must refer to a delegation account of a zone in the genesis state.
+	for _, record := range gs.WithdrawalRecords {
+		if !delegators[record.Delegator] {
+			return fmt.Errorf("no zone for withdrawal record %s of delegator %s", record.Txhash, record.Delegator)
+		}
+	}
+
+	return nil
 }
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +30,152 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ZoneState is the operational state of a zone.
+type ZoneState int32
+
+const (
+	ZoneStateActive            ZoneState = 0
+	ZoneStateDepositsPaused    ZoneState = 1
+	ZoneStateRedemptionsPaused ZoneState = 2
+	ZoneStatePaused            ZoneState = 3
+)
+
+var ZoneState_name = map[int32]string{
+	0: "ZoneStateActive",
+	1: "ZoneStateDepositsPaused",
+	2: "ZoneStateRedemptionsPaused",
+	3: "ZoneStatePaused",
+}
+
+var ZoneState_value = map[string]int32{
+	"ZoneStateActive":            0,
+	"ZoneStateDepositsPaused":    1,
+	"ZoneStateRedemptionsPaused": 2,
+	"ZoneStatePaused":            3,
+}
+
+func (x ZoneState) String() string {
+	return proto.EnumName(ZoneState_name, int32(x))
+}
+
+func (ZoneState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{0}
+}
+
+// WithdrawalRecordStatus is the status of a withdrawal record.
+type WithdrawalRecordStatus int32
+
+const (
+	// WithdrawStatusUnspecified is not a valid status.
+	WithdrawStatusUnspecified WithdrawalRecordStatus = 0
+	// WithdrawStatusTokenize records await the tokenization of delegations to
+	// the recipient, on zones supporting the liquid staking module.
+	WithdrawStatusTokenize WithdrawalRecordStatus = 1
+	// WithdrawStatusSend records await the acknowledgement of the send to the
+	// recipient.
+	WithdrawStatusSend WithdrawalRecordStatus = 2
+	// WithdrawStatusQueued records await unbonding (or tokenization) at the end
+	// of the epoch.
+	WithdrawStatusQueued WithdrawalRecordStatus = 3
+	// WithdrawStatusUnbond records await the completion of unbonding.
+	WithdrawStatusUnbond WithdrawalRecordStatus = 4
+	// WithdrawStatusCompleted records have been paid out to the recipient.
+	WithdrawStatusCompleted WithdrawalRecordStatus = 5
+	// WithdrawStatusFailed records could not be processed by the host chain.
+	WithdrawStatusFailed WithdrawalRecordStatus = 6
+)
+
+var WithdrawalRecordStatus_name = map[int32]string{
+	0: "WithdrawStatusUnspecified",
+	1: "WithdrawStatusTokenize",
+	2: "WithdrawStatusSend",
+	3: "WithdrawStatusQueued",
+	4: "WithdrawStatusUnbond",
+	5: "WithdrawStatusCompleted",
+	6: "WithdrawStatusFailed",
+}
+
+var WithdrawalRecordStatus_value = map[string]int32{
+	"WithdrawStatusUnspecified": 0,
+	"WithdrawStatusTokenize":    1,
+	"WithdrawStatusSend":        2,
+	"WithdrawStatusQueued":      3,
+	"WithdrawStatusUnbond":      4,
+	"WithdrawStatusCompleted":   5,
+	"WithdrawStatusFailed":      6,
+}
+
+func (x WithdrawalRecordStatus) String() string {
+	return proto.EnumName(WithdrawalRecordStatus_name, int32(x))
+}
+
+func (WithdrawalRecordStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{1}
+}
+
+// RefundStatus is the status of a refund.
+type RefundStatus int32
+
+const (
+	RefundStatusPending   RefundStatus = 0
+	RefundStatusSent      RefundStatus = 1
+	RefundStatusCompleted RefundStatus = 2
+)
+
+var RefundStatus_name = map[int32]string{
+	0: "RefundStatusPending",
+	1: "RefundStatusSent",
+	2: "RefundStatusCompleted",
+}
+
+var RefundStatus_value = map[string]int32{
+	"RefundStatusPending":   0,
+	"RefundStatusSent":      1,
+	"RefundStatusCompleted": 2,
+}
+
+func (x RefundStatus) String() string {
+	return proto.EnumName(RefundStatus_name, int32(x))
+}
+
+func (RefundStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{2}
+}
+
+// IcaOperationStatus is the status of an unresolved interchain account
+// operation.
+type IcaOperationStatus int32
+
+const (
+	// IcaOperationPending operations await an acknowledgement or timeout.
+	IcaOperationPending IcaOperationStatus = 0
+	// IcaOperationFailed operations were acknowledged with an error on every
+	// attempt.
+	IcaOperationFailed IcaOperationStatus = 1
+	// IcaOperationTimedOut operations timed out, closing the channel.
+	IcaOperationTimedOut IcaOperationStatus = 2
+)
+
+var IcaOperationStatus_name = map[int32]string{
+	0: "IcaOperationPending",
+	1: "IcaOperationFailed",
+	2: "IcaOperationTimedOut",
+}
+
+var IcaOperationStatus_value = map[string]int32{
+	"IcaOperationPending":  0,
+	"IcaOperationFailed":   1,
+	"IcaOperationTimedOut": 2,
+}
+
+func (x IcaOperationStatus) String() string {
+	return proto.EnumName(IcaOperationStatus_name, int32(x))
+}
+
+func (IcaOperationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{3}
+}
+
 type RegisteredZone struct {
 	ConnectionId                 string                                   `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 	ChainId                      string                                   `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -46,6 +196,44 @@ type RegisteredZone struct {
 	IbcNextValidatorsHash        []byte                                   `protobuf:"bytes,17,opt,name=ibc_next_validators_hash,json=ibcNextValidatorsHash,proto3" json:"ibc_next_validators_hash,omitempty"`
 	ValidatorSelectionAllocation github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=validator_selection_allocation,json=validatorSelectionAllocation,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"validator_selection_allocation"`
 	HoldingsAllocation           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,19,rep,name=holdings_allocation,json=holdingsAllocation,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"holdings_allocation"`
+	// deferred_epoch is the number of the epoch whose processing was skipped
+	// because one or more interchain accounts were unavailable; zero if none.
+	DeferredEpoch int64 `protobuf:"varint,20,opt,name=deferred_epoch,json=deferredEpoch,proto3" json:"deferred_epoch,omitempty"`
+	// sunsetting is set by a DeregisterZoneProposal; the zone no longer accepts
+	// deposits and all delegations are unbonded.
+	Sunsetting bool `protobuf:"varint,21,opt,name=sunsetting,proto3" json:"sunsetting,omitempty"`
+	// sunset_completion is the latest unbonding completion time of the sunset
+	// undelegations.
+	SunsetCompletion time.Time `protobuf:"bytes,22,opt,name=sunset_completion,json=sunsetCompletion,proto3,stdtime" json:"sunset_completion"`
+	// commission_rate is the proportion of rewards collected as fees.
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// max_redemption_rate_change is the maximum relative change of the
+	// redemption rate in a single epoch; zero disables the bound.
+	MaxRedemptionRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,24,opt,name=max_redemption_rate_change,json=maxRedemptionRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_redemption_rate_change"`
+	// min_redemption_rate is the absolute floor of the redemption rate; zero
+	// disables the bound.
+	MinRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,25,opt,name=min_redemption_rate,json=minRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_redemption_rate"`
+	// max_redemption_rate is the absolute ceiling of the redemption rate; zero
+	// disables the bound.
+	MaxRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=max_redemption_rate,json=maxRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_redemption_rate"`
+	// circuit_breaker_tripped is set when a redemption rate update breached the
+	// zone's bounds; minting and redemptions are paused until a
+	// ResumeZoneProposal passes.
+	CircuitBreakerTripped bool `protobuf:"varint,27,opt,name=circuit_breaker_tripped,json=circuitBreakerTripped,proto3" json:"circuit_breaker_tripped,omitempty"`
+	// state is the operational state of the zone, set by governance.
+	State ZoneState `protobuf:"varint,28,opt,name=state,proto3,enum=quicksilver.interchainstaking.v1.ZoneState" json:"state,omitempty"`
+	// validator_denylist are the validators excluded from intents and
+	// delegation, set by governance.
+	ValidatorDenylist []string `protobuf:"bytes,29,rep,name=validator_denylist,json=validatorDenylist,proto3" json:"validator_denylist,omitempty"`
+	// validator_allowlist, if non-empty, are the only validators eligible for
+	// intents and delegation, set by governance.
+	ValidatorAllowlist []string `protobuf:"bytes,30,rep,name=validator_allowlist,json=validatorAllowlist,proto3" json:"validator_allowlist,omitempty"`
+	// delegation_account_count is the number of active delegation accounts of
+	// the zone, set by governance.
+	DelegationAccountCount uint32 `protobuf:"varint,31,opt,name=delegation_account_count,json=delegationAccountCount,proto3" json:"delegation_account_count,omitempty"`
+	// rewards_dust is the amount of rewards left undistributed by the previous
+	// rewards distribution, carried forward to the next.
+	RewardsDust github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,32,opt,name=rewards_dust,json=rewardsDust,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"rewards_dust"`
 }
 
 func (m *RegisteredZone) Reset()         { *m = RegisteredZone{} }
@@ -200,6 +388,62 @@ func (m *RegisteredZone) GetHoldingsAllocation() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
+func (m *RegisteredZone) GetDeferredEpoch() int64 {
+	if m != nil {
+		return m.DeferredEpoch
+	}
+	return 0
+}
+
+func (m *RegisteredZone) GetSunsetting() bool {
+	if m != nil {
+		return m.Sunsetting
+	}
+	return false
+}
+
+func (m *RegisteredZone) GetSunsetCompletion() time.Time {
+	if m != nil {
+		return m.SunsetCompletion
+	}
+	return time.Time{}
+}
+
+func (m *RegisteredZone) GetCircuitBreakerTripped() bool {
+	if m != nil {
+		return m.CircuitBreakerTripped
+	}
+	return false
+}
+
+func (m *RegisteredZone) GetState() ZoneState {
+	if m != nil {
+		return m.State
+	}
+	return ZoneStateActive
+}
+
+func (m *RegisteredZone) GetValidatorDenylist() []string {
+	if m != nil {
+		return m.ValidatorDenylist
+	}
+	return nil
+}
+
+func (m *RegisteredZone) GetValidatorAllowlist() []string {
+	if m != nil {
+		return m.ValidatorAllowlist
+	}
+	return nil
+}
+
+func (m *RegisteredZone) GetDelegationAccountCount() uint32 {
+	if m != nil {
+		return m.DelegationAccountCount
+	}
+	return 0
+}
+
 type ICAAccount struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// balance defines the different coins this balance holds.
@@ -207,6 +451,12 @@ type ICAAccount struct {
 	DelegatedBalance github_com_cosmos_cosmos_sdk_types.Coin  `protobuf:"bytes,3,opt,name=delegated_balance,json=delegatedBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"delegated_balance"`
 	PortName         string                                   `protobuf:"bytes,4,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
 	BalanceWaitgroup uint32                                   `protobuf:"varint,5,opt,name=balance_waitgroup,json=balanceWaitgroup,proto3" json:"balance_waitgroup,omitempty"`
+	// unavailable is set when the account channel has closed, until the
+	// channel is re-opened.
+	Unavailable bool `protobuf:"varint,6,opt,name=unavailable,proto3" json:"unavailable,omitempty"`
+	// retired is set on delegation accounts beyond the zone's delegation account
+	// count; retired accounts receive no new delegations, and are drained.
+	Retired bool `protobuf:"varint,7,opt,name=retired,proto3" json:"retired,omitempty"`
 }
 
 func (m *ICAAccount) Reset()         { *m = ICAAccount{} }
@@ -270,6 +520,20 @@ func (m *ICAAccount) GetBalanceWaitgroup() uint32 {
 	return 0
 }
 
+func (m *ICAAccount) GetUnavailable() bool {
+	if m != nil {
+		return m.Unavailable
+	}
+	return false
+}
+
+func (m *ICAAccount) GetRetired() bool {
+	if m != nil {
+		return m.Retired
+	}
+	return false
+}
+
 type WithdrawalRecord struct {
 	Delegator  string                                  `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 	Validator  string                                  `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
@@ -277,7 +541,14 @@ type WithdrawalRecord struct {
 	Amount     github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
 	BurnAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=burn_amount,json=burnAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"burn_amount"`
 	Txhash     string                                  `protobuf:"bytes,6,opt,name=txhash,proto3" json:"txhash,omitempty"`
-	Status     int32                                   `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	Status     WithdrawalRecordStatus                  `protobuf:"varint,7,opt,name=status,proto3,enum=quicksilver.interchainstaking.v1.WithdrawalRecordStatus" json:"status,omitempty"`
+	// completion_time is the expected completion time of an unbonding
+	// withdrawal, once the undelegation has been acknowledged, or the time at
+	// which the withdrawal completed.
+	CompletionTime time.Time `protobuf:"bytes,8,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// unbonding_batch is the memo of the interchain account transaction that
+	// undelegates the withdrawal; its acknowledgement is matched against it.
+	UnbondingBatch string `protobuf:"bytes,9,opt,name=unbonding_batch,json=unbondingBatch,proto3" json:"unbonding_batch,omitempty"`
 }
 
 func (m *WithdrawalRecord) Reset()         { *m = WithdrawalRecord{} }
@@ -341,11 +612,93 @@ func (m *WithdrawalRecord) GetTxhash() string {
 	return ""
 }
 
-func (m *WithdrawalRecord) GetStatus() int32 {
+func (m *WithdrawalRecord) GetStatus() WithdrawalRecordStatus {
 	if m != nil {
 		return m.Status
 	}
-	return 0
+	return WithdrawStatusUnspecified
+}
+
+func (m *WithdrawalRecord) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *WithdrawalRecord) GetUnbondingBatch() string {
+	if m != nil {
+		return m.UnbondingBatch
+	}
+	return ""
+}
+
+// RedemptionEscrow records the qAssets held by the module account for a
+// redemption, keyed by the redemption hash. The escrow is burned once the
+// withdrawal records of the redemption complete; the share of failed records
+// is refunded to the redeemer.
+type RedemptionEscrow struct {
+	ChainId  string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Txhash   string `protobuf:"bytes,2,opt,name=txhash,proto3" json:"txhash,omitempty"`
+	Redeemer string `protobuf:"bytes,3,opt,name=redeemer,proto3" json:"redeemer,omitempty"`
+	// amount is the amount of qAssets escrowed for the redemption.
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// refunded is the amount of qAssets refunded for failed withdrawals.
+	Refunded github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=refunded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"refunded"`
+}
+
+func (m *RedemptionEscrow) Reset()         { *m = RedemptionEscrow{} }
+func (m *RedemptionEscrow) String() string { return proto.CompactTextString(m) }
+func (*RedemptionEscrow) ProtoMessage()    {}
+func (*RedemptionEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{3}
+}
+func (m *RedemptionEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionEscrow.Merge(m, src)
+}
+func (m *RedemptionEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionEscrow proto.InternalMessageInfo
+
+func (m *RedemptionEscrow) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *RedemptionEscrow) GetTxhash() string {
+	if m != nil {
+		return m.Txhash
+	}
+	return ""
+}
+
+func (m *RedemptionEscrow) GetRedeemer() string {
+	if m != nil {
+		return m.Redeemer
+	}
+	return ""
 }
 
 type TransferRecord struct {
@@ -358,7 +711,7 @@ func (m *TransferRecord) Reset()         { *m = TransferRecord{} }
 func (m *TransferRecord) String() string { return proto.CompactTextString(m) }
 func (*TransferRecord) ProtoMessage()    {}
 func (*TransferRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{3}
+	return fileDescriptor_196cdf77e041fc72, []int{4}
 }
 func (m *TransferRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,13 +760,21 @@ type Validator struct {
 	DelegatorShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares"`
 	VotingPower     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power"`
 	Score           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+	// status is the bond status of the validator on the host chain.
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// jailed is true if the validator is jailed on the host chain.
+	Jailed bool `protobuf:"varint,7,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// tombstoned is true if the validator has been tombstoned on the host chain.
+	Tombstoned bool `protobuf:"varint,8,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// consensus_address is the bech32 consensus address of the validator.
+	ConsensusAddress string `protobuf:"bytes,9,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{4}
+	return fileDescriptor_196cdf77e041fc72, []int{5}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,6 +810,34 @@ func (m *Validator) GetValoperAddress() string {
 	return ""
 }
 
+func (m *Validator) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Validator) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *Validator) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *Validator) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
 type DelegatorIntent struct {
 	Delegator string             `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 	Intents   []*ValidatorIntent `protobuf:"bytes,2,rep,name=intents,proto3" json:"intents,omitempty"`
@@ -458,7 +847,7 @@ func (m *DelegatorIntent) Reset()         { *m = DelegatorIntent{} }
 func (m *DelegatorIntent) String() string { return proto.CompactTextString(m) }
 func (*DelegatorIntent) ProtoMessage()    {}
 func (*DelegatorIntent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{5}
+	return fileDescriptor_196cdf77e041fc72, []int{6}
 }
 func (m *DelegatorIntent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +899,7 @@ func (m *ValidatorIntent) Reset()         { *m = ValidatorIntent{} }
 func (m *ValidatorIntent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorIntent) ProtoMessage()    {}
 func (*ValidatorIntent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{6}
+	return fileDescriptor_196cdf77e041fc72, []int{7}
 }
 func (m *ValidatorIntent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +947,7 @@ func (m *Delegation) Reset()         { *m = Delegation{} }
 func (m *Delegation) String() string { return proto.CompactTextString(m) }
 func (*Delegation) ProtoMessage()    {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{7}
+	return fileDescriptor_196cdf77e041fc72, []int{8}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,7 +1013,7 @@ func (m *PortConnectionTuple) Reset()         { *m = PortConnectionTuple{} }
 func (m *PortConnectionTuple) String() string { return proto.CompactTextString(m) }
 func (*PortConnectionTuple) ProtoMessage()    {}
 func (*PortConnectionTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{8}
+	return fileDescriptor_196cdf77e041fc72, []int{9}
 }
 func (m *PortConnectionTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,13 +1061,17 @@ type Receipt struct {
 	Sender string                                   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 	Txhash string                                   `protobuf:"bytes,3,opt,name=txhash,proto3" json:"txhash,omitempty"`
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// qasset_amount is the amount of qAssets minted for the deposit.
+	QassetAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=qasset_amount,json=qassetAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"qasset_amount"`
+	// delegation_plans are the delegations planned for the deposit.
+	DelegationPlans []DelegationPlan `protobuf:"bytes,6,rep,name=delegation_plans,json=delegationPlans,proto3" json:"delegation_plans"`
 }
 
 func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{9}
+	return fileDescriptor_196cdf77e041fc72, []int{10}
 }
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,24 +1128,47 @@ func (m *Receipt) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
-type DelegationPlan struct {
-	ValidatorAddress string                                   `protobuf:"bytes,1,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
-	DelegatorAddress string                                   `protobuf:"bytes,2,opt,name=delegatorAddress,proto3" json:"delegatorAddress,omitempty"`
-	Value            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=value,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"value"`
+func (m *Receipt) GetQassetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.QassetAmount
+	}
+	return nil
 }
 
-func (m *DelegationPlan) Reset()         { *m = DelegationPlan{} }
-func (m *DelegationPlan) String() string { return proto.CompactTextString(m) }
-func (*DelegationPlan) ProtoMessage()    {}
-func (*DelegationPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{10}
+func (m *Receipt) GetDelegationPlans() []DelegationPlan {
+	if m != nil {
+		return m.DelegationPlans
+	}
+	return nil
 }
-func (m *DelegationPlan) XXX_Unmarshal(b []byte) error {
+
+// Refund records a rejected deposit, to be returned to its sender from the
+// deposit account.
+type Refund struct {
+	ChainId   string                                   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Txhash    string                                   `protobuf:"bytes,2,opt,name=txhash,proto3" json:"txhash,omitempty"`
+	Recipient string                                   `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// reason is the reason the deposit was rejected.
+	Reason string       `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status RefundStatus `protobuf:"varint,6,opt,name=status,proto3,enum=quicksilver.interchainstaking.v1.RefundStatus" json:"status,omitempty"`
+	// batch is the memo of the interchain account transaction that sent the
+	// refund; its acknowledgement is matched against it.
+	Batch string `protobuf:"bytes,7,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (m *Refund) Reset()         { *m = Refund{} }
+func (m *Refund) String() string { return proto.CompactTextString(m) }
+func (*Refund) ProtoMessage()    {}
+func (*Refund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{11}
+}
+func (m *Refund) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegationPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Refund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegationPlan.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Refund.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -762,60 +1178,96 @@ func (m *DelegationPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *DelegationPlan) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegationPlan.Merge(m, src)
+func (m *Refund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Refund.Merge(m, src)
 }
-func (m *DelegationPlan) XXX_Size() int {
+func (m *Refund) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegationPlan) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegationPlan.DiscardUnknown(m)
+func (m *Refund) XXX_DiscardUnknown() {
+	xxx_messageInfo_Refund.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegationPlan proto.InternalMessageInfo
+var xxx_messageInfo_Refund proto.InternalMessageInfo
 
-func (m *DelegationPlan) GetValidatorAddress() string {
+func (m *Refund) GetChainId() string {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.ChainId
 	}
 	return ""
 }
 
-func (m *DelegationPlan) GetDelegatorAddress() string {
+func (m *Refund) GetTxhash() string {
 	if m != nil {
-		return m.DelegatorAddress
+		return m.Txhash
 	}
 	return ""
 }
 
-func (m *DelegationPlan) GetValue() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *Refund) GetRecipient() string {
 	if m != nil {
-		return m.Value
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Refund) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
 	return nil
 }
 
-type Params struct {
-	DelegationAccountCount uint64                                 `protobuf:"varint,1,opt,name=delegation_account_count,json=delegationAccountCount,proto3" json:"delegation_account_count,omitempty"`
-	DelegationAccountSplit uint64                                 `protobuf:"varint,2,opt,name=delegation_account_split,json=delegationAccountSplit,proto3" json:"delegation_account_split,omitempty"`
-	DepositInterval        uint64                                 `protobuf:"varint,3,opt,name=deposit_interval,json=depositInterval,proto3" json:"deposit_interval,omitempty"`
-	DelegateInterval       uint64                                 `protobuf:"varint,4,opt,name=delegate_interval,json=delegateInterval,proto3" json:"delegate_interval,omitempty"`
-	DelegationsInterval    uint64                                 `protobuf:"varint,5,opt,name=delegations_interval,json=delegationsInterval,proto3" json:"delegations_interval,omitempty"`
-	ValidatorsetInterval   uint64                                 `protobuf:"varint,6,opt,name=validatorset_interval,json=validatorsetInterval,proto3" json:"validatorset_interval,omitempty"`
-	CommissionRate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+func (m *Refund) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *Params) Reset()      { *m = Params{} }
-func (*Params) ProtoMessage() {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{11}
+func (m *Refund) GetStatus() RefundStatus {
+	if m != nil {
+		return m.Status
+	}
+	return RefundStatusPending
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+
+func (m *Refund) GetBatch() string {
+	if m != nil {
+		return m.Batch
+	}
+	return ""
+}
+
+// IBCDeposit records a deposit received by IBC transfer, forwarded to the
+// zone deposit account and pending acknowledgement.
+type IBCDeposit struct {
+	ChainId  string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// amount is the deposited amount, in the zone base denom.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// intent is the validator intent memo of the deposit.
+	Intent string `protobuf:"bytes,4,opt,name=intent,proto3" json:"intent,omitempty"`
+	// channel_id and sequence identify the forwarding transfer packet.
+	ChannelId string `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// acknowledged is set when the forwarding transfer has been acknowledged,
+	// but qAssets could not yet be minted.
+	Acknowledged bool `protobuf:"varint,7,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+}
+
+func (m *IBCDeposit) Reset()         { *m = IBCDeposit{} }
+func (m *IBCDeposit) String() string { return proto.CompactTextString(m) }
+func (*IBCDeposit) ProtoMessage()    {}
+func (*IBCDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{12}
+}
+func (m *IBCDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IBCDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IBCDeposit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -825,77 +1277,94 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *IBCDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCDeposit.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *IBCDeposit) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *IBCDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCDeposit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_IBCDeposit proto.InternalMessageInfo
 
-func (m *Params) GetDelegationAccountCount() uint64 {
+func (m *IBCDeposit) GetChainId() string {
 	if m != nil {
-		return m.DelegationAccountCount
+		return m.ChainId
 	}
-	return 0
+	return ""
 }
 
-func (m *Params) GetDelegationAccountSplit() uint64 {
+func (m *IBCDeposit) GetReceiver() string {
 	if m != nil {
-		return m.DelegationAccountSplit
+		return m.Receiver
 	}
-	return 0
+	return ""
 }
 
-func (m *Params) GetDepositInterval() uint64 {
+func (m *IBCDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.DepositInterval
+		return m.Amount
 	}
-	return 0
+	return nil
 }
 
-func (m *Params) GetDelegateInterval() uint64 {
+func (m *IBCDeposit) GetIntent() string {
 	if m != nil {
-		return m.DelegateInterval
+		return m.Intent
 	}
-	return 0
+	return ""
 }
 
-func (m *Params) GetDelegationsInterval() uint64 {
+func (m *IBCDeposit) GetChannelId() string {
 	if m != nil {
-		return m.DelegationsInterval
+		return m.ChannelId
 	}
-	return 0
+	return ""
 }
 
-func (m *Params) GetValidatorsetInterval() uint64 {
+func (m *IBCDeposit) GetSequence() uint64 {
 	if m != nil {
-		return m.ValidatorsetInterval
+		return m.Sequence
 	}
 	return 0
 }
 
-type DelegationsForZone struct {
-	ChainId     string        `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Delegations []*Delegation `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations,omitempty"`
+func (m *IBCDeposit) GetAcknowledged() bool {
+	if m != nil {
+		return m.Acknowledged
+	}
+	return false
 }
 
-func (m *DelegationsForZone) Reset()         { *m = DelegationsForZone{} }
-func (m *DelegationsForZone) String() string { return proto.CompactTextString(m) }
-func (*DelegationsForZone) ProtoMessage()    {}
-func (*DelegationsForZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{12}
+// SlashingIncident records a slash of a validator on the host chain, detected
+// from a fall in the validator's token to share ratio.
+type SlashingIncident struct {
+	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// height is the block height at which the slash was detected.
+	Height int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// fraction is the fraction of the validator's tokens that were slashed.
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// tokens_lost is the amount of the zone's delegated tokens that were
+	// slashed.
+	TokensLost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=tokens_lost,json=tokensLost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens_lost"`
+}
+
+func (m *SlashingIncident) Reset()         { *m = SlashingIncident{} }
+func (m *SlashingIncident) String() string { return proto.CompactTextString(m) }
+func (*SlashingIncident) ProtoMessage()    {}
+func (*SlashingIncident) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{13}
 }
-func (m *DelegationsForZone) XXX_Unmarshal(b []byte) error {
+func (m *SlashingIncident) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegationsForZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SlashingIncident) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegationsForZone.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SlashingIncident.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -905,49 +1374,79 @@ func (m *DelegationsForZone) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DelegationsForZone) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegationsForZone.Merge(m, src)
+func (m *SlashingIncident) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingIncident.Merge(m, src)
 }
-func (m *DelegationsForZone) XXX_Size() int {
+func (m *SlashingIncident) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegationsForZone) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegationsForZone.DiscardUnknown(m)
+func (m *SlashingIncident) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingIncident.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegationsForZone proto.InternalMessageInfo
+var xxx_messageInfo_SlashingIncident proto.InternalMessageInfo
 
-func (m *DelegationsForZone) GetChainId() string {
+func (m *SlashingIncident) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-func (m *DelegationsForZone) GetDelegations() []*Delegation {
+func (m *SlashingIncident) GetValidator() string {
 	if m != nil {
-		return m.Delegations
+		return m.Validator
 	}
-	return nil
+	return ""
 }
 
-type DelegationPlansForZone struct {
-	ChainId         string                     `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	DelegationPlans map[string]*DelegationPlan `protobuf:"bytes,2,rep,name=delegation_plans,json=delegationPlans,proto3" json:"delegation_plans,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (m *SlashingIncident) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *DelegationPlansForZone) Reset()         { *m = DelegationPlansForZone{} }
-func (m *DelegationPlansForZone) String() string { return proto.CompactTextString(m) }
-func (*DelegationPlansForZone) ProtoMessage()    {}
-func (*DelegationPlansForZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{13}
+func (m *SlashingIncident) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// IcaOperation is an interchain account transaction (or a chunk of one)
+// submitted as a single packet, keyed by port, channel and sequence. Pending
+// operations are removed once successfully acknowledged; failed and timed out
+// operations are retained for inspection.
+type IcaOperation struct {
+	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	PortId    string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Memo      string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	// data is the serialized CosmosTx of the operation.
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// attempts is the number of times the operation has been submitted.
+	Attempts uint32 `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// msg_types are the type urls of the messages of the operation.
+	MsgTypes []string `protobuf:"bytes,8,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	// height is the block height at which the operation was submitted.
+	Height int64              `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	Status IcaOperationStatus `protobuf:"varint,10,opt,name=status,proto3,enum=quicksilver.interchainstaking.v1.IcaOperationStatus" json:"status,omitempty"`
+}
+
+func (m *IcaOperation) Reset()         { *m = IcaOperation{} }
+func (m *IcaOperation) String() string { return proto.CompactTextString(m) }
+func (*IcaOperation) ProtoMessage()    {}
+func (*IcaOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{14}
 }
-func (m *DelegationPlansForZone) XXX_Unmarshal(b []byte) error {
+func (m *IcaOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegationPlansForZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IcaOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegationPlansForZone.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IcaOperation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -957,50 +1456,106 @@ func (m *DelegationPlansForZone) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *DelegationPlansForZone) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegationPlansForZone.Merge(m, src)
+func (m *IcaOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaOperation.Merge(m, src)
 }
-func (m *DelegationPlansForZone) XXX_Size() int {
+func (m *IcaOperation) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegationPlansForZone) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegationPlansForZone.DiscardUnknown(m)
+func (m *IcaOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaOperation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegationPlansForZone proto.InternalMessageInfo
+var xxx_messageInfo_IcaOperation proto.InternalMessageInfo
 
-func (m *DelegationPlansForZone) GetChainId() string {
+func (m *IcaOperation) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-func (m *DelegationPlansForZone) GetDelegationPlans() map[string]*DelegationPlan {
+func (m *IcaOperation) GetPortId() string {
 	if m != nil {
-		return m.DelegationPlans
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *IcaOperation) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IcaOperation) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *IcaOperation) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *IcaOperation) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-type DelegatorIntentsForZone struct {
-	ChainId          string             `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	DelegationIntent []*DelegatorIntent `protobuf:"bytes,2,rep,name=delegation_intent,json=delegationIntent,proto3" json:"delegation_intent,omitempty"`
-	Snapshot         bool               `protobuf:"varint,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+func (m *IcaOperation) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
 }
 
-func (m *DelegatorIntentsForZone) Reset()         { *m = DelegatorIntentsForZone{} }
-func (m *DelegatorIntentsForZone) String() string { return proto.CompactTextString(m) }
-func (*DelegatorIntentsForZone) ProtoMessage()    {}
-func (*DelegatorIntentsForZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{14}
+func (m *IcaOperation) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
 }
-func (m *DelegatorIntentsForZone) XXX_Unmarshal(b []byte) error {
+
+func (m *IcaOperation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *IcaOperation) GetStatus() IcaOperationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return IcaOperationPending
+}
+
+type DelegationPlan struct {
+	ValidatorAddress string                                   `protobuf:"bytes,1,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
+	DelegatorAddress string                                   `protobuf:"bytes,2,opt,name=delegatorAddress,proto3" json:"delegatorAddress,omitempty"`
+	Value            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=value,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"value"`
+}
+
+func (m *DelegationPlan) Reset()         { *m = DelegationPlan{} }
+func (m *DelegationPlan) String() string { return proto.CompactTextString(m) }
+func (*DelegationPlan) ProtoMessage()    {}
+func (*DelegationPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{15}
+}
+func (m *DelegationPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegatorIntentsForZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegationPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegatorIntentsForZone.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegationPlan.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1010,62 +1565,70 @@ func (m *DelegatorIntentsForZone) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *DelegatorIntentsForZone) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegatorIntentsForZone.Merge(m, src)
+func (m *DelegationPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationPlan.Merge(m, src)
 }
-func (m *DelegatorIntentsForZone) XXX_Size() int {
+func (m *DelegationPlan) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegatorIntentsForZone) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegatorIntentsForZone.DiscardUnknown(m)
+func (m *DelegationPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationPlan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegatorIntentsForZone proto.InternalMessageInfo
+var xxx_messageInfo_DelegationPlan proto.InternalMessageInfo
 
-func (m *DelegatorIntentsForZone) GetChainId() string {
+func (m *DelegationPlan) GetValidatorAddress() string {
 	if m != nil {
-		return m.ChainId
+		return m.ValidatorAddress
 	}
 	return ""
 }
 
-func (m *DelegatorIntentsForZone) GetDelegationIntent() []*DelegatorIntent {
+func (m *DelegationPlan) GetDelegatorAddress() string {
 	if m != nil {
-		return m.DelegationIntent
+		return m.DelegatorAddress
 	}
-	return nil
+	return ""
 }
 
-func (m *DelegatorIntentsForZone) GetSnapshot() bool {
+func (m *DelegationPlan) GetValue() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Snapshot
+		return m.Value
 	}
-	return false
+	return nil
 }
 
-// GenesisState defines the interchainstaking module's genesis state.
-type GenesisState struct {
-	Params           Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Zones            []RegisteredZone          `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones"`
-	Receipts         []Receipt                 `protobuf:"bytes,3,rep,name=receipts,proto3" json:"receipts"`
-	Delegations      []DelegationsForZone      `protobuf:"bytes,4,rep,name=delegations,proto3" json:"delegations"`
-	DelegationPlans  []DelegationPlansForZone  `protobuf:"bytes,5,rep,name=delegation_plans,json=delegationPlans,proto3" json:"delegation_plans"`
-	DelegatorIntents []DelegatorIntentsForZone `protobuf:"bytes,6,rep,name=delegator_intents,json=delegatorIntents,proto3" json:"delegator_intents"`
-	PortConnections  []PortConnectionTuple     `protobuf:"bytes,7,rep,name=port_connections,json=portConnections,proto3" json:"port_connections"`
+type Params struct {
+	DelegationAccountCount uint64                                 `protobuf:"varint,1,opt,name=delegation_account_count,json=delegationAccountCount,proto3" json:"delegation_account_count,omitempty"`
+	DelegationAccountSplit uint64                                 `protobuf:"varint,2,opt,name=delegation_account_split,json=delegationAccountSplit,proto3" json:"delegation_account_split,omitempty"`
+	DepositInterval        uint64                                 `protobuf:"varint,3,opt,name=deposit_interval,json=depositInterval,proto3" json:"deposit_interval,omitempty"`
+	DelegateInterval       uint64                                 `protobuf:"varint,4,opt,name=delegate_interval,json=delegateInterval,proto3" json:"delegate_interval,omitempty"`
+	DelegationsInterval    uint64                                 `protobuf:"varint,5,opt,name=delegations_interval,json=delegationsInterval,proto3" json:"delegations_interval,omitempty"`
+	ValidatorsetInterval   uint64                                 `protobuf:"varint,6,opt,name=validatorset_interval,json=validatorsetInterval,proto3" json:"validatorset_interval,omitempty"`
+	CommissionRate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// community_pool_fee_share is the proportion of collected fees sent to the
+	// community pool.
+	CommunityPoolFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=community_pool_fee_share,json=communityPoolFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_fee_share"`
+	// treasury_fee_share is the proportion of collected fees sent to the
+	// treasury address. The remainder is distributed to stakers.
+	TreasuryFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=treasury_fee_share,json=treasuryFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"treasury_fee_share"`
+	TreasuryAddress  string                                 `protobuf:"bytes,10,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+	// max_msgs_per_tx is the maximum number of messages submitted in a single
+	// interchain account transaction; larger submissions are split into chunks.
+	MaxMsgsPerTx uint64 `protobuf:"varint,11,opt,name=max_msgs_per_tx,json=maxMsgsPerTx,proto3" json:"max_msgs_per_tx,omitempty"`
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_196cdf77e041fc72, []int{15}
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{16}
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1075,555 +1638,803 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *Params) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func (m *GenesisState) GetParams() Params {
+func (m *Params) GetDelegationAccountCount() uint64 {
 	if m != nil {
-		return m.Params
+		return m.DelegationAccountCount
 	}
-	return Params{}
+	return 0
 }
 
-func (m *GenesisState) GetZones() []RegisteredZone {
+func (m *Params) GetDelegationAccountSplit() uint64 {
 	if m != nil {
-		return m.Zones
+		return m.DelegationAccountSplit
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetReceipts() []Receipt {
+func (m *Params) GetDepositInterval() uint64 {
 	if m != nil {
-		return m.Receipts
+		return m.DepositInterval
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetDelegations() []DelegationsForZone {
+func (m *Params) GetDelegateInterval() uint64 {
 	if m != nil {
-		return m.Delegations
+		return m.DelegateInterval
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetDelegationPlans() []DelegationPlansForZone {
+func (m *Params) GetDelegationsInterval() uint64 {
 	if m != nil {
-		return m.DelegationPlans
+		return m.DelegationsInterval
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetDelegatorIntents() []DelegatorIntentsForZone {
+func (m *Params) GetValidatorsetInterval() uint64 {
 	if m != nil {
-		return m.DelegatorIntents
+		return m.ValidatorsetInterval
 	}
-	return nil
+	return 0
 }
 
-func (m *GenesisState) GetPortConnections() []PortConnectionTuple {
+func (m *Params) GetTreasuryAddress() string {
 	if m != nil {
-		return m.PortConnections
+		return m.TreasuryAddress
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*RegisteredZone)(nil), "quicksilver.interchainstaking.v1.RegisteredZone")
-	proto.RegisterMapType((map[string]*ValidatorIntent)(nil), "quicksilver.interchainstaking.v1.RegisteredZone.AggregateIntentEntry")
-	proto.RegisterType((*ICAAccount)(nil), "quicksilver.interchainstaking.v1.ICAAccount")
-	proto.RegisterType((*WithdrawalRecord)(nil), "quicksilver.interchainstaking.v1.WithdrawalRecord")
-	proto.RegisterType((*TransferRecord)(nil), "quicksilver.interchainstaking.v1.TransferRecord")
-	proto.RegisterType((*Validator)(nil), "quicksilver.interchainstaking.v1.Validator")
-	proto.RegisterType((*DelegatorIntent)(nil), "quicksilver.interchainstaking.v1.DelegatorIntent")
-	proto.RegisterType((*ValidatorIntent)(nil), "quicksilver.interchainstaking.v1.ValidatorIntent")
-	proto.RegisterType((*Delegation)(nil), "quicksilver.interchainstaking.v1.Delegation")
-	proto.RegisterType((*PortConnectionTuple)(nil), "quicksilver.interchainstaking.v1.PortConnectionTuple")
-	proto.RegisterType((*Receipt)(nil), "quicksilver.interchainstaking.v1.Receipt")
-	proto.RegisterType((*DelegationPlan)(nil), "quicksilver.interchainstaking.v1.DelegationPlan")
-	proto.RegisterType((*Params)(nil), "quicksilver.interchainstaking.v1.Params")
-	proto.RegisterType((*DelegationsForZone)(nil), "quicksilver.interchainstaking.v1.DelegationsForZone")
-	proto.RegisterType((*DelegationPlansForZone)(nil), "quicksilver.interchainstaking.v1.DelegationPlansForZone")
-	proto.RegisterMapType((map[string]*DelegationPlan)(nil), "quicksilver.interchainstaking.v1.DelegationPlansForZone.DelegationPlansEntry")
-	proto.RegisterType((*DelegatorIntentsForZone)(nil), "quicksilver.interchainstaking.v1.DelegatorIntentsForZone")
-	proto.RegisterType((*GenesisState)(nil), "quicksilver.interchainstaking.v1.GenesisState")
+func (m *Params) GetMaxMsgsPerTx() uint64 {
+	if m != nil {
+		return m.MaxMsgsPerTx
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("quicksilver/interchainstaking/v1/genesis.proto", fileDescriptor_196cdf77e041fc72)
+type DelegationsForZone struct {
+	ChainId     string        `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Delegations []*Delegation `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations,omitempty"`
 }
 
-var fileDescriptor_196cdf77e041fc72 = []byte{
-	// 1824 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcb, 0x6f, 0x23, 0x49,
-	0x19, 0x4f, 0xdb, 0xb1, 0x93, 0x7c, 0xce, 0xc4, 0x4e, 0x25, 0x3b, 0xdb, 0x13, 0x20, 0x89, 0x8c,
-	0x80, 0xac, 0x96, 0xd8, 0x93, 0x59, 0x1e, 0xc3, 0x08, 0x21, 0x9c, 0xc9, 0xcc, 0x10, 0x0d, 0x3b,
-	0x8a, 0x3a, 0xcb, 0xae, 0x34, 0x3c, 0x5a, 0xe5, 0xee, 0x1a, 0xbb, 0x94, 0x76, 0x55, 0x4f, 0x57,
-	0xd9, 0x49, 0x56, 0x48, 0x70, 0xe2, 0xbc, 0x5c, 0x10, 0xc7, 0x95, 0xb8, 0x21, 0x21, 0x71, 0x98,
-	0x3f, 0x81, 0xc3, 0x1e, 0x57, 0xc3, 0x05, 0x38, 0x0c, 0x68, 0xe6, 0xc2, 0x85, 0x0b, 0x47, 0x0e,
-	0x80, 0xaa, 0xba, 0xfa, 0x61, 0x27, 0x5a, 0xdb, 0x59, 0xef, 0x5e, 0x66, 0x52, 0xdf, 0xe3, 0xf7,
-	0x55, 0xd7, 0xf7, 0xac, 0x32, 0x34, 0x9e, 0xf6, 0xa9, 0x77, 0x22, 0x68, 0x30, 0x20, 0x51, 0x93,
-	0x32, 0x49, 0x22, 0xaf, 0x8b, 0x29, 0x13, 0x12, 0x9f, 0x50, 0xd6, 0x69, 0x0e, 0xf6, 0x9a, 0x1d,
-	0xc2, 0x88, 0xa0, 0xa2, 0x11, 0x46, 0x5c, 0x72, 0xb4, 0x9d, 0x93, 0x6f, 0x5c, 0x90, 0x6f, 0x0c,
-	0xf6, 0x36, 0xd6, 0x3b, 0xbc, 0xc3, 0xb5, 0x70, 0x53, 0xfd, 0x15, 0xeb, 0x6d, 0xdc, 0xf0, 0xb8,
-	0xe8, 0x71, 0xe1, 0xc6, 0x8c, 0x78, 0x61, 0x58, 0x9b, 0xf1, 0xaa, 0xd9, 0xc6, 0x82, 0x34, 0x07,
-	0x7b, 0x6d, 0x22, 0xf1, 0x5e, 0xd3, 0xe3, 0x94, 0xc5, 0xfc, 0xfa, 0x2f, 0x97, 0x61, 0xc5, 0x21,
-	0x1d, 0x2a, 0x24, 0x89, 0x88, 0xff, 0x98, 0x33, 0x82, 0xbe, 0x0c, 0xd7, 0x3c, 0xce, 0x18, 0xf1,
-	0x24, 0xe5, 0xcc, 0xa5, 0xbe, 0x6d, 0x6d, 0x5b, 0x3b, 0x4b, 0xce, 0x72, 0x46, 0x3c, 0xf4, 0xd1,
-	0x0d, 0x58, 0xd4, 0x7b, 0x53, 0xfc, 0x82, 0xe6, 0x2f, 0xe8, 0xf5, 0xa1, 0x8f, 0x7e, 0x04, 0x55,
-	0x9f, 0x84, 0x5c, 0x50, 0xe9, 0x62, 0xdf, 0x8f, 0x88, 0x10, 0x76, 0x71, 0xdb, 0xda, 0xa9, 0xdc,
-	0xfa, 0x7a, 0x63, 0xdc, 0xf7, 0x35, 0x0e, 0xef, 0xb6, 0x5a, 0x9e, 0xc7, 0xfb, 0x4c, 0x3a, 0x2b,
-	0x06, 0xa4, 0x15, 0x63, 0xa0, 0x1f, 0x03, 0x3a, 0xa5, 0xb2, 0xeb, 0x47, 0xf8, 0x14, 0x07, 0x29,
-	0xf2, 0xfc, 0x15, 0x90, 0x57, 0x33, 0x9c, 0x04, 0xfc, 0xa7, 0xb0, 0x16, 0x92, 0xe8, 0x09, 0x8f,
-	0x7a, 0x98, 0x79, 0x24, 0x45, 0x2f, 0x5d, 0x01, 0x1d, 0xe5, 0x80, 0x12, 0x78, 0x17, 0xd6, 0x7d,
-	0x12, 0x90, 0x0e, 0xd6, 0x47, 0x6a, 0xd0, 0x89, 0xb0, 0xcb, 0xdb, 0xc5, 0xa9, 0xf1, 0xd7, 0x32,
-	0xa4, 0x56, 0x02, 0x84, 0xbe, 0x02, 0x2b, 0x38, 0xe6, 0xbb, 0x61, 0x44, 0x9e, 0xd0, 0x33, 0x7b,
-	0x41, 0x3b, 0xe5, 0x9a, 0xa1, 0x1e, 0x69, 0x22, 0xda, 0x82, 0x4a, 0xc0, 0x3d, 0x1c, 0xb8, 0x3e,
-	0x61, 0xbc, 0x67, 0x2f, 0x6a, 0x19, 0xd0, 0xa4, 0x03, 0x45, 0x41, 0x5f, 0x02, 0x50, 0x91, 0x62,
-	0xf8, 0x4b, 0x9a, 0xbf, 0xa4, 0x28, 0x31, 0x9b, 0x40, 0x35, 0x22, 0x3e, 0xe9, 0x85, 0xfa, 0x3b,
-	0x22, 0x2c, 0x89, 0x0d, 0x4a, 0x66, 0xff, 0xbb, 0x1f, 0xbd, 0xd8, 0x9a, 0xfb, 0xdb, 0x8b, 0xad,
-	0xaf, 0x76, 0xa8, 0xec, 0xf6, 0xdb, 0x0d, 0x8f, 0xf7, 0x4c, 0x1c, 0x9a, 0xff, 0x76, 0x85, 0x7f,
-	0xd2, 0x94, 0xe7, 0x21, 0x11, 0x8d, 0x03, 0xe2, 0x3d, 0x7f, 0xb6, 0x0b, 0x26, 0x4c, 0x0f, 0x88,
-	0xe7, 0xac, 0x64, 0xa0, 0x0e, 0x96, 0x04, 0x31, 0x58, 0x0f, 0xb0, 0x90, 0xee, 0xa8, 0xad, 0xca,
-	0x0c, 0x6c, 0x21, 0x85, 0xec, 0x0c, 0xdb, 0x7b, 0x08, 0x30, 0xc0, 0x01, 0xf5, 0xb1, 0xe4, 0x91,
-	0xb0, 0x97, 0xb5, 0x53, 0xde, 0x1c, 0xef, 0x94, 0x77, 0x13, 0x1d, 0x27, 0xa7, 0x8e, 0x42, 0xa8,
-	0xe1, 0x4e, 0x27, 0x52, 0x2e, 0x22, 0xae, 0xd2, 0x63, 0xd2, 0xbe, 0xa6, 0x21, 0xef, 0x8d, 0x87,
-	0x1c, 0x4e, 0xc5, 0x46, 0x2b, 0x01, 0x3a, 0xd4, 0x38, 0xf7, 0x98, 0x8c, 0xce, 0x9d, 0x2a, 0x1e,
-	0xa6, 0x2a, 0xa7, 0xf5, 0xfa, 0x81, 0xa4, 0xae, 0x20, 0xcc, 0xb7, 0x57, 0xb6, 0xad, 0x9d, 0x45,
-	0x67, 0x49, 0x53, 0x8e, 0x09, 0xf3, 0xd1, 0x1b, 0x50, 0x0b, 0xe8, 0xd3, 0x3e, 0xf5, 0xa9, 0x3c,
-	0x77, 0x7b, 0xdc, 0xef, 0x07, 0xc4, 0xae, 0x6a, 0xa1, 0x6a, 0x4a, 0x7f, 0x5b, 0x93, 0xd1, 0x1e,
-	0xac, 0xe7, 0x72, 0xec, 0x14, 0x53, 0xd9, 0x89, 0x78, 0x3f, 0xb4, 0x6b, 0xdb, 0xd6, 0xce, 0x35,
-	0x67, 0x2d, 0xe3, 0xbd, 0x97, 0xb0, 0xd0, 0xb7, 0xc1, 0xa6, 0x6d, 0xcf, 0x65, 0xe4, 0x4c, 0xba,
-	0xd9, 0x29, 0xb8, 0x5d, 0x2c, 0xba, 0xf6, 0xea, 0xb6, 0xb5, 0xb3, 0xec, 0xbc, 0x46, 0xdb, 0xde,
-	0x23, 0x72, 0x26, 0xd3, 0xe3, 0x12, 0x3f, 0xc0, 0xa2, 0x8b, 0x7e, 0x6d, 0xc1, 0x66, 0xaa, 0xe0,
-	0x0a, 0x12, 0x98, 0x82, 0x83, 0x03, 0x15, 0x8f, 0xea, 0x4f, 0x1b, 0xe9, 0x63, 0xbb, 0xd1, 0x30,
-	0xee, 0x53, 0x71, 0xd8, 0x30, 0x35, 0xac, 0x71, 0x97, 0x53, 0xb6, 0x7f, 0x53, 0x85, 0xc2, 0xef,
-	0xff, 0xbe, 0xb5, 0x33, 0x41, 0x28, 0x28, 0x05, 0xe1, 0x7c, 0x31, 0x35, 0x79, 0x9c, 0x58, 0x6c,
-	0xa5, 0x06, 0xd1, 0xcf, 0x61, 0xad, 0xcb, 0x03, 0x9f, 0xb2, 0x8e, 0xc8, 0xef, 0x63, 0x6d, 0xf6,
-	0xfb, 0x40, 0x89, 0x9d, 0xcc, 0xfa, 0x46, 0x1f, 0xd6, 0x2f, 0x73, 0x38, 0xaa, 0x41, 0xf1, 0x84,
-	0x9c, 0x9b, 0x32, 0xac, 0xfe, 0x44, 0x0f, 0xa0, 0x34, 0xc0, 0x41, 0x9f, 0xe8, 0xd2, 0x5b, 0xb9,
-	0xb5, 0x37, 0x45, 0xac, 0xc6, 0xc0, 0x4e, 0xac, 0x7f, 0xa7, 0x70, 0xdb, 0xaa, 0xff, 0xa7, 0x00,
-	0x90, 0xd5, 0x17, 0x74, 0x0b, 0x16, 0x92, 0xf2, 0xa7, 0x2d, 0xee, 0xdb, 0xcf, 0x9f, 0xed, 0xae,
-	0x9b, 0x4f, 0x37, 0x15, 0xe7, 0x58, 0x46, 0x94, 0x75, 0x9c, 0x44, 0x10, 0x11, 0x58, 0x68, 0xe3,
-	0x40, 0x55, 0x3c, 0xbb, 0x30, 0xfb, 0xb3, 0x4a, 0xb0, 0xd1, 0xaf, 0x2c, 0x58, 0x35, 0xd5, 0x8f,
-	0xf8, 0x6e, 0x62, 0x31, 0x6e, 0x2e, 0x9f, 0x60, 0xf1, 0x7b, 0xa6, 0x60, 0x7c, 0x6d, 0x42, 0x8b,
-	0xcf, 0x9f, 0xed, 0x56, 0x0c, 0x98, 0x5a, 0x3a, 0xb5, 0xd4, 0xe6, 0xbe, 0xd9, 0xc8, 0x17, 0x60,
-	0x29, 0xe4, 0x91, 0x74, 0x19, 0xee, 0x11, 0xdd, 0x82, 0x96, 0x9c, 0x45, 0x45, 0x78, 0x84, 0x7b,
-	0x04, 0xbd, 0x09, 0xab, 0x66, 0x6b, 0xb9, 0x0c, 0x2a, 0xe9, 0x0c, 0xaa, 0x19, 0x46, 0x9a, 0x3e,
-	0xf5, 0xbf, 0x16, 0xa1, 0xf6, 0x5e, 0x9a, 0x56, 0x0e, 0xf1, 0x78, 0xe4, 0xa3, 0x6f, 0xc1, 0x92,
-	0x31, 0xc9, 0xa3, 0xb1, 0x4e, 0xc8, 0x44, 0x95, 0x5e, 0x1a, 0xde, 0x71, 0x57, 0xfe, 0x24, 0xbd,
-	0x54, 0x54, 0xe9, 0x45, 0xc4, 0xa3, 0x21, 0x55, 0xb5, 0xaa, 0x38, 0x4e, 0x2f, 0x15, 0x45, 0x4f,
-	0xa1, 0x8c, 0x7b, 0x2a, 0x68, 0x4c, 0x1b, 0xfe, 0x0c, 0x7d, 0x60, 0x0c, 0xa1, 0xf7, 0xa1, 0xd2,
-	0xee, 0x47, 0xcc, 0x35, 0x76, 0x4b, 0x9f, 0xb5, 0x5d, 0x50, 0xd6, 0x5a, 0xb1, 0xed, 0xeb, 0x50,
-	0x96, 0x67, 0xba, 0xb0, 0x95, 0xb5, 0xcb, 0xcd, 0x4a, 0xd1, 0x85, 0xc4, 0xb2, 0x2f, 0x74, 0xd3,
-	0x2d, 0x39, 0x66, 0x55, 0xff, 0x97, 0x05, 0x2b, 0xef, 0x44, 0x98, 0x89, 0x27, 0x24, 0x32, 0x9e,
-	0xbd, 0x09, 0x65, 0x55, 0xa4, 0xc9, 0x78, 0xb7, 0x1a, 0xb9, 0x61, 0xdf, 0x14, 0xae, 0xe2, 0x9b,
-	0xe2, 0xe7, 0xe4, 0x9b, 0xfa, 0x9f, 0x8b, 0xb0, 0x94, 0xd6, 0x19, 0xd4, 0x82, 0xea, 0x00, 0x07,
-	0x3c, 0x24, 0x91, 0x3b, 0x69, 0x3d, 0x59, 0x31, 0x0a, 0xad, 0xb4, 0xac, 0x54, 0x3d, 0xde, 0xeb,
-	0x51, 0x21, 0xd2, 0x11, 0xa0, 0x30, 0x8b, 0x71, 0x23, 0x03, 0xd5, 0xed, 0xbf, 0x03, 0xb5, 0x34,
-	0x87, 0x5c, 0xd1, 0xc5, 0x11, 0x11, 0x26, 0x0b, 0x3e, 0x9d, 0x9d, 0x6a, 0x8a, 0x7a, 0xac, 0x41,
-	0x91, 0x0b, 0xcb, 0x03, 0x2e, 0x29, 0xeb, 0xb8, 0x21, 0x3f, 0x25, 0x51, 0x5c, 0x39, 0xa6, 0x32,
-	0x72, 0xc8, 0x64, 0xce, 0xc8, 0x21, 0x93, 0x4e, 0x25, 0x46, 0x3c, 0x52, 0x80, 0xc8, 0x81, 0x92,
-	0xf0, 0x78, 0x44, 0x74, 0x5e, 0x7c, 0xda, 0xed, 0xc7, 0x50, 0xf5, 0xdf, 0x58, 0x50, 0x3d, 0x48,
-	0x3e, 0xc4, 0x4c, 0x1c, 0x57, 0x2d, 0x50, 0x0f, 0x61, 0x21, 0x9e, 0x88, 0x84, 0xe9, 0x13, 0x57,
-	0xe8, 0x5c, 0x09, 0x42, 0xfd, 0x4f, 0x16, 0x54, 0x47, 0x98, 0xb3, 0x08, 0x3a, 0x06, 0xe5, 0x53,
-	0x42, 0x3b, 0xdd, 0x24, 0xdb, 0xde, 0x9d, 0xee, 0x10, 0xff, 0xfd, 0x62, 0xeb, 0xfa, 0x39, 0xee,
-	0x05, 0x77, 0xea, 0x11, 0x09, 0xb0, 0xa4, 0x03, 0xe2, 0xc6, 0x70, 0xf5, 0x91, 0xe3, 0x2d, 0x27,
-	0xe4, 0x02, 0xc0, 0x41, 0x3a, 0xd2, 0xa3, 0x07, 0x80, 0x2e, 0x5e, 0x15, 0xc6, 0x7e, 0xc4, 0xea,
-	0x85, 0x4b, 0x01, 0xba, 0x07, 0xab, 0xd9, 0x78, 0x95, 0xe0, 0x8c, 0x2b, 0x20, 0xb5, 0x54, 0x25,
-	0x81, 0xf9, 0xfc, 0xeb, 0x88, 0xaa, 0xa7, 0xdd, 0xd8, 0x03, 0x2a, 0x41, 0x8a, 0x8e, 0x59, 0xa9,
-	0x41, 0x56, 0xdd, 0x08, 0xd2, 0xc3, 0x51, 0xd3, 0x6e, 0x49, 0x4b, 0x54, 0xf3, 0xf4, 0x7b, 0xcc,
-	0xaf, 0x1f, 0xc3, 0xda, 0x11, 0x8f, 0xe4, 0xdd, 0xf4, 0xca, 0xfa, 0x4e, 0x3f, 0x0c, 0x26, 0xbc,
-	0xda, 0xbe, 0x0e, 0x0b, 0xba, 0xb9, 0xa7, 0x37, 0xdb, 0xb2, 0x5a, 0x1e, 0xfa, 0xf5, 0xff, 0x5a,
-	0xb0, 0xe0, 0x10, 0x8f, 0xd0, 0x50, 0xa2, 0x03, 0x98, 0x7f, 0x9f, 0x33, 0xa2, 0x01, 0x2a, 0xb7,
-	0x6e, 0x4e, 0x3b, 0xd9, 0x3b, 0x5a, 0x3b, 0xd7, 0x0e, 0x0a, 0x13, 0xb6, 0x83, 0xac, 0x07, 0x15,
-	0x87, 0x7a, 0x90, 0x97, 0x6b, 0xc5, 0x33, 0x1f, 0xc0, 0x92, 0x02, 0xff, 0x3f, 0x0b, 0x56, 0xb2,
-	0x50, 0x3d, 0x0a, 0x30, 0x43, 0x07, 0x70, 0x21, 0x64, 0xc6, 0x06, 0xeb, 0xc5, 0x20, 0x3b, 0xc8,
-	0x55, 0xe0, 0xd6, 0xa4, 0xa1, 0x3a, 0xaa, 0x81, 0x70, 0x32, 0x15, 0x17, 0x67, 0x7f, 0x04, 0x31,
-	0x72, 0xfd, 0x0f, 0x45, 0x28, 0x1f, 0xe1, 0x08, 0xf7, 0x04, 0xba, 0x0d, 0x76, 0x3e, 0x51, 0xcd,
-	0xed, 0x5b, 0xff, 0xab, 0x4f, 0x60, 0xde, 0xb9, 0x9e, 0x4b, 0xca, 0x98, 0x7d, 0x57, 0xc7, 0xf7,
-	0xe5, 0x9a, 0x22, 0x0c, 0x68, 0x5c, 0x73, 0x2e, 0xd3, 0x3c, 0x56, 0x5c, 0x95, 0x01, 0xc9, 0xd3,
-	0x8a, 0x0e, 0xb2, 0x01, 0x0e, 0x74, 0x1c, 0xcc, 0x3b, 0xc9, 0x93, 0xcb, 0xa1, 0x21, 0xab, 0x29,
-	0x34, 0x19, 0x5b, 0x33, 0xd9, 0x79, 0x2d, 0x9b, 0xce, 0xb3, 0xa9, 0xf0, 0x5e, 0xfe, 0x7d, 0x42,
-	0x64, 0xf2, 0x25, 0x2d, 0x9f, 0x7b, 0x71, 0x10, 0xa9, 0xca, 0x5b, 0xf0, 0x5a, 0x76, 0xdd, 0x23,
-	0xb9, 0xfd, 0x94, 0xb5, 0xce, 0x7a, 0x9e, 0x99, 0x2a, 0x5d, 0xd2, 0xd0, 0x17, 0x66, 0xdf, 0xd0,
-	0xef, 0x2c, 0xfe, 0xf6, 0xc3, 0xad, 0xb9, 0x7f, 0x7e, 0xb8, 0x65, 0xd5, 0x7f, 0x01, 0x28, 0x0b,
-	0x58, 0x71, 0x9f, 0x47, 0xfa, 0x85, 0x2b, 0xff, 0x78, 0x65, 0x0d, 0x3f, 0x5e, 0x3d, 0x82, 0x4a,
-	0xee, 0x6b, 0x4d, 0x97, 0x9a, 0xe0, 0x81, 0x26, 0xb3, 0xe2, 0xe4, 0x01, 0xea, 0xbf, 0x2b, 0xc0,
-	0xf5, 0xe1, 0x94, 0x99, 0x64, 0x17, 0x67, 0x69, 0x3e, 0xa8, 0x73, 0x0a, 0x95, 0x96, 0xd9, 0xca,
-	0xdb, 0xd3, 0x6c, 0x25, 0x6f, 0x6e, 0x94, 0x6c, 0xde, 0x12, 0xfc, 0x61, 0xea, 0x86, 0x84, 0xf5,
-	0xcb, 0x04, 0x2f, 0xb9, 0x83, 0xde, 0x1f, 0xbe, 0x83, 0xde, 0x9c, 0x76, 0x63, 0xf9, 0x2b, 0xe8,
-	0x1f, 0x2d, 0x78, 0x7d, 0x64, 0xc6, 0x98, 0xe4, 0x98, 0x7e, 0x06, 0xb9, 0xbe, 0x97, 0xbc, 0xb5,
-	0x4c, 0x3c, 0x58, 0x8c, 0x18, 0x74, 0x72, 0x47, 0x6e, 0xa6, 0x89, 0x0d, 0x58, 0x14, 0x0c, 0x87,
-	0xa2, 0xcb, 0xe3, 0xee, 0xb7, 0xe8, 0xa4, 0xeb, 0xfa, 0x07, 0x25, 0x58, 0x7e, 0x10, 0xbf, 0xde,
-	0x1e, 0x4b, 0x35, 0x45, 0xde, 0x87, 0x72, 0xa8, 0x2b, 0x83, 0xe9, 0x09, 0x3b, 0xe3, 0x77, 0x10,
-	0x57, 0x92, 0xfd, 0x79, 0x15, 0xfc, 0x8e, 0xd1, 0x46, 0x3f, 0x84, 0x92, 0xea, 0x0d, 0x89, 0xc3,
-	0xa7, 0x6e, 0x2d, 0x06, 0x2e, 0x06, 0x41, 0x0f, 0x61, 0x31, 0x8a, 0x5b, 0x96, 0x30, 0x65, 0xf1,
-	0x8d, 0x49, 0x00, 0xb5, 0x86, 0x41, 0x4a, 0x01, 0xd0, 0x4f, 0x86, 0x93, 0x23, 0xee, 0x34, 0xdf,
-	0x98, 0xc6, 0xf1, 0x89, 0x57, 0x0d, 0x74, 0x1e, 0x0e, 0xd1, 0x4b, 0x82, 0xbe, 0xa4, 0x4d, 0xdc,
-	0xbe, 0x6a, 0xd0, 0x1b, 0x33, 0xa3, 0x51, 0x8e, 0x82, 0x34, 0x70, 0x78, 0xe4, 0x26, 0x13, 0x69,
-	0xfc, 0x18, 0xfb, 0x9d, 0xa9, 0x03, 0x67, 0xc4, 0x58, 0xd6, 0x97, 0x0c, 0x1b, 0x3d, 0x81, 0x9a,
-	0x1e, 0x28, 0xb2, 0x29, 0x43, 0xdd, 0x14, 0x95, 0xb1, 0x6f, 0x4e, 0x10, 0x23, 0x17, 0xc7, 0x98,
-	0xe4, 0xab, 0xc2, 0x21, 0x96, 0xd8, 0x7f, 0xfc, 0xd1, 0xcb, 0x4d, 0xeb, 0xe3, 0x97, 0x9b, 0xd6,
-	0x3f, 0x5e, 0x6e, 0x5a, 0x1f, 0xbc, 0xda, 0x9c, 0xfb, 0xf8, 0xd5, 0xe6, 0xdc, 0x5f, 0x5e, 0x6d,
-	0xce, 0x3d, 0xfe, 0x7e, 0xae, 0xac, 0x52, 0xd6, 0x21, 0xac, 0x4f, 0xe5, 0xf9, 0x6e, 0xbb, 0x4f,
-	0x03, 0xbf, 0x99, 0xff, 0x8d, 0xe2, 0xec, 0x92, 0x5f, 0x29, 0x74, 0xd1, 0x6d, 0x97, 0xf5, 0xcf,
-	0x05, 0x6f, 0xfd, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x10, 0xed, 0x59, 0xf0, 0xd3, 0x18, 0x00, 0x00,
+func (m *DelegationsForZone) Reset()         { *m = DelegationsForZone{} }
+func (m *DelegationsForZone) String() string { return proto.CompactTextString(m) }
+func (*DelegationsForZone) ProtoMessage()    {}
+func (*DelegationsForZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{17}
+}
+func (m *DelegationsForZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationsForZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationsForZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationsForZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationsForZone.Merge(m, src)
+}
+func (m *DelegationsForZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationsForZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationsForZone.DiscardUnknown(m)
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_DelegationsForZone proto.InternalMessageInfo
+
+func (m *DelegationsForZone) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
+	return ""
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *DelegationsForZone) GetDelegations() []*Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+type DelegationPlansForZone struct {
+	ChainId         string                     `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	DelegationPlans map[string]*DelegationPlan `protobuf:"bytes,2,rep,name=delegation_plans,json=delegationPlans,proto3" json:"delegation_plans,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *DelegationPlansForZone) Reset()         { *m = DelegationPlansForZone{} }
+func (m *DelegationPlansForZone) String() string { return proto.CompactTextString(m) }
+func (*DelegationPlansForZone) ProtoMessage()    {}
+func (*DelegationPlansForZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{18}
+}
+func (m *DelegationPlansForZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationPlansForZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationPlansForZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *DelegationPlansForZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationPlansForZone.Merge(m, src)
+}
+func (m *DelegationPlansForZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationPlansForZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationPlansForZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationPlansForZone proto.InternalMessageInfo
+
+func (m *DelegationPlansForZone) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if this.DelegationAccountCount != that1.DelegationAccountCount {
-		return false
+	return ""
+}
+
+func (m *DelegationPlansForZone) GetDelegationPlans() map[string]*DelegationPlan {
+	if m != nil {
+		return m.DelegationPlans
 	}
-	if this.DelegationAccountSplit != that1.DelegationAccountSplit {
-		return false
+	return nil
+}
+
+type DelegatorIntentsForZone struct {
+	ChainId          string             `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	DelegationIntent []*DelegatorIntent `protobuf:"bytes,2,rep,name=delegation_intent,json=delegationIntent,proto3" json:"delegation_intent,omitempty"`
+	Snapshot         bool               `protobuf:"varint,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (m *DelegatorIntentsForZone) Reset()         { *m = DelegatorIntentsForZone{} }
+func (m *DelegatorIntentsForZone) String() string { return proto.CompactTextString(m) }
+func (*DelegatorIntentsForZone) ProtoMessage()    {}
+func (*DelegatorIntentsForZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{19}
+}
+func (m *DelegatorIntentsForZone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorIntentsForZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorIntentsForZone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.DepositInterval != that1.DepositInterval {
-		return false
+}
+func (m *DelegatorIntentsForZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorIntentsForZone.Merge(m, src)
+}
+func (m *DelegatorIntentsForZone) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorIntentsForZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorIntentsForZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorIntentsForZone proto.InternalMessageInfo
+
+func (m *DelegatorIntentsForZone) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if this.DelegateInterval != that1.DelegateInterval {
-		return false
+	return ""
+}
+
+func (m *DelegatorIntentsForZone) GetDelegationIntent() []*DelegatorIntent {
+	if m != nil {
+		return m.DelegationIntent
 	}
-	if this.DelegationsInterval != that1.DelegationsInterval {
-		return false
+	return nil
+}
+
+func (m *DelegatorIntentsForZone) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
 	}
-	if this.ValidatorsetInterval != that1.ValidatorsetInterval {
-		return false
+	return false
+}
+
+// ZoneFees are the cumulative fees collected for a zone.
+type ZoneFees struct {
+	ChainId string                                   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Fees    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *ZoneFees) Reset()         { *m = ZoneFees{} }
+func (m *ZoneFees) String() string { return proto.CompactTextString(m) }
+func (*ZoneFees) ProtoMessage()    {}
+func (*ZoneFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{20}
+}
+func (m *ZoneFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.CommissionRate.Equal(that1.CommissionRate) {
-		return false
+}
+func (m *ZoneFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneFees.Merge(m, src)
+}
+func (m *ZoneFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneFees proto.InternalMessageInfo
+
+func (m *ZoneFees) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return true
+	return ""
 }
-func (m *RegisteredZone) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *ZoneFees) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RegisteredZone) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValsetPass records the validators of a zone seen in the current, incomplete
+// pass over the zone's validators of the given status.
+type ValsetPass struct {
+	ChainId    string   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Status     string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Validators []string `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (m *RegisteredZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HoldingsAllocation) > 0 {
-		for iNdEx := len(m.HoldingsAllocation) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HoldingsAllocation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
+func (m *ValsetPass) Reset()         { *m = ValsetPass{} }
+func (m *ValsetPass) String() string { return proto.CompactTextString(m) }
+func (*ValsetPass) ProtoMessage()    {}
+func (*ValsetPass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{21}
+}
+func (m *ValsetPass) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValsetPass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValsetPass.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ValidatorSelectionAllocation) > 0 {
-		for iNdEx := len(m.ValidatorSelectionAllocation) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorSelectionAllocation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+}
+func (m *ValsetPass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValsetPass.Merge(m, src)
+}
+func (m *ValsetPass) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValsetPass) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValsetPass.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValsetPass proto.InternalMessageInfo
+
+func (m *ValsetPass) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.IbcNextValidatorsHash) > 0 {
-		i -= len(m.IbcNextValidatorsHash)
-		copy(dAtA[i:], m.IbcNextValidatorsHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.IbcNextValidatorsHash)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return ""
+}
+
+func (m *ValsetPass) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	if m.WithdrawalWaitgroup != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.WithdrawalWaitgroup))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+	return ""
+}
+
+func (m *ValsetPass) GetValidators() []string {
+	if m != nil {
+		return m.Validators
 	}
-	if m.LiquidityModule {
-		i--
-		if m.LiquidityModule {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+// GenesisState defines the interchainstaking module's genesis state.
+type GenesisState struct {
+	Params            Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Zones             []RegisteredZone          `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones"`
+	Receipts          []Receipt                 `protobuf:"bytes,3,rep,name=receipts,proto3" json:"receipts"`
+	Delegations       []DelegationsForZone      `protobuf:"bytes,4,rep,name=delegations,proto3" json:"delegations"`
+	DelegationPlans   []DelegationPlansForZone  `protobuf:"bytes,5,rep,name=delegation_plans,json=delegationPlans,proto3" json:"delegation_plans"`
+	DelegatorIntents  []DelegatorIntentsForZone `protobuf:"bytes,6,rep,name=delegator_intents,json=delegatorIntents,proto3" json:"delegator_intents"`
+	PortConnections   []PortConnectionTuple     `protobuf:"bytes,7,rep,name=port_connections,json=portConnections,proto3" json:"port_connections"`
+	WithdrawalRecords []WithdrawalRecord        `protobuf:"bytes,8,rep,name=withdrawal_records,json=withdrawalRecords,proto3" json:"withdrawal_records"`
+	Refunds           []Refund                  `protobuf:"bytes,9,rep,name=refunds,proto3" json:"refunds"`
+	IbcDeposits       []IBCDeposit              `protobuf:"bytes,10,rep,name=ibc_deposits,json=ibcDeposits,proto3" json:"ibc_deposits"`
+	SlashingIncidents []SlashingIncident        `protobuf:"bytes,11,rep,name=slashing_incidents,json=slashingIncidents,proto3" json:"slashing_incidents"`
+	IcaOperations     []IcaOperation            `protobuf:"bytes,12,rep,name=ica_operations,json=icaOperations,proto3" json:"ica_operations"`
+	ZoneFees          []ZoneFees                `protobuf:"bytes,13,rep,name=zone_fees,json=zoneFees,proto3" json:"zone_fees"`
+	RedemptionEscrows []RedemptionEscrow        `protobuf:"bytes,14,rep,name=redemption_escrows,json=redemptionEscrows,proto3" json:"redemption_escrows"`
+	ValsetPasses      []ValsetPass              `protobuf:"bytes,15,rep,name=valset_passes,json=valsetPasses,proto3" json:"valset_passes"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_196cdf77e041fc72, []int{22}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x78
+		return b[:n], nil
 	}
-	if m.MultiSend {
-		i--
-		if m.MultiSend {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	if len(m.AggregateIntent) > 0 {
-		for k := range m.AggregateIntent {
-			v := m.AggregateIntent[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintGenesis(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x6a
-		}
+	return Params{}
+}
+
+func (m *GenesisState) GetZones() []RegisteredZone {
+	if m != nil {
+		return m.Zones
 	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+	return nil
+}
+
+func (m *GenesisState) GetReceipts() []Receipt {
+	if m != nil {
+		return m.Receipts
 	}
-	{
-		size := m.LastRedemptionRate.Size()
-		i -= size
-		if _, err := m.LastRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetDelegations() []DelegationsForZone {
+	if m != nil {
+		return m.Delegations
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.RedemptionRate.Size()
-		i -= size
-		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetDelegationPlans() []DelegationPlansForZone {
+	if m != nil {
+		return m.DelegationPlans
 	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x4a
+	return nil
+}
+
+func (m *GenesisState) GetDelegatorIntents() []DelegatorIntentsForZone {
+	if m != nil {
+		return m.DelegatorIntents
 	}
-	if len(m.LocalDenom) > 0 {
-		i -= len(m.LocalDenom)
-		copy(dAtA[i:], m.LocalDenom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LocalDenom)))
-		i--
-		dAtA[i] = 0x42
+	return nil
+}
+
+func (m *GenesisState) GetPortConnections() []PortConnectionTuple {
+	if m != nil {
+		return m.PortConnections
 	}
-	if len(m.AccountPrefix) > 0 {
-		i -= len(m.AccountPrefix)
-		copy(dAtA[i:], m.AccountPrefix)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AccountPrefix)))
-		i--
-		dAtA[i] = 0x3a
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawalRecords() []WithdrawalRecord {
+	if m != nil {
+		return m.WithdrawalRecords
 	}
-	if len(m.DelegationAddresses) > 0 {
-		for iNdEx := len(m.DelegationAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegationAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	return nil
+}
+
+func (m *GenesisState) GetRefunds() []Refund {
+	if m != nil {
+		return m.Refunds
 	}
-	if m.PerformanceAddress != nil {
-		{
-			size, err := m.PerformanceAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *GenesisState) GetIbcDeposits() []IBCDeposit {
+	if m != nil {
+		return m.IbcDeposits
 	}
-	if m.WithdrawalAddress != nil {
-		{
-			size, err := m.WithdrawalAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *GenesisState) GetSlashingIncidents() []SlashingIncident {
+	if m != nil {
+		return m.SlashingIncidents
 	}
-	if m.DepositAddress != nil {
-		{
-			size, err := m.DepositAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *GenesisState) GetIcaOperations() []IcaOperation {
+	if m != nil {
+		return m.IcaOperations
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *GenesisState) GetZoneFees() []ZoneFees {
+	if m != nil {
+		return m.ZoneFees
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *GenesisState) GetRedemptionEscrows() []RedemptionEscrow {
+	if m != nil {
+		return m.RedemptionEscrows
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetValsetPasses() []ValsetPass {
+	if m != nil {
+		return m.ValsetPasses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("quicksilver.interchainstaking.v1.ZoneState", ZoneState_name, ZoneState_value)
+	proto.RegisterEnum("quicksilver.interchainstaking.v1.WithdrawalRecordStatus", WithdrawalRecordStatus_name, WithdrawalRecordStatus_value)
+	proto.RegisterEnum("quicksilver.interchainstaking.v1.RefundStatus", RefundStatus_name, RefundStatus_value)
+	proto.RegisterEnum("quicksilver.interchainstaking.v1.IcaOperationStatus", IcaOperationStatus_name, IcaOperationStatus_value)
+	proto.RegisterType((*RegisteredZone)(nil), "quicksilver.interchainstaking.v1.RegisteredZone")
+	proto.RegisterMapType((map[string]*ValidatorIntent)(nil), "quicksilver.interchainstaking.v1.RegisteredZone.AggregateIntentEntry")
+	proto.RegisterType((*ICAAccount)(nil), "quicksilver.interchainstaking.v1.ICAAccount")
+	proto.RegisterType((*WithdrawalRecord)(nil), "quicksilver.interchainstaking.v1.WithdrawalRecord")
+	proto.RegisterType((*RedemptionEscrow)(nil), "quicksilver.interchainstaking.v1.RedemptionEscrow")
+	proto.RegisterType((*TransferRecord)(nil), "quicksilver.interchainstaking.v1.TransferRecord")
+	proto.RegisterType((*Validator)(nil), "quicksilver.interchainstaking.v1.Validator")
+	proto.RegisterType((*DelegatorIntent)(nil), "quicksilver.interchainstaking.v1.DelegatorIntent")
+	proto.RegisterType((*ValidatorIntent)(nil), "quicksilver.interchainstaking.v1.ValidatorIntent")
+	proto.RegisterType((*Delegation)(nil), "quicksilver.interchainstaking.v1.Delegation")
+	proto.RegisterType((*PortConnectionTuple)(nil), "quicksilver.interchainstaking.v1.PortConnectionTuple")
+	proto.RegisterType((*Receipt)(nil), "quicksilver.interchainstaking.v1.Receipt")
+	proto.RegisterType((*Refund)(nil), "quicksilver.interchainstaking.v1.Refund")
+	proto.RegisterType((*IBCDeposit)(nil), "quicksilver.interchainstaking.v1.IBCDeposit")
+	proto.RegisterType((*SlashingIncident)(nil), "quicksilver.interchainstaking.v1.SlashingIncident")
+	proto.RegisterType((*IcaOperation)(nil), "quicksilver.interchainstaking.v1.IcaOperation")
+	proto.RegisterType((*DelegationPlan)(nil), "quicksilver.interchainstaking.v1.DelegationPlan")
+	proto.RegisterType((*Params)(nil), "quicksilver.interchainstaking.v1.Params")
+	proto.RegisterType((*DelegationsForZone)(nil), "quicksilver.interchainstaking.v1.DelegationsForZone")
+	proto.RegisterType((*DelegationPlansForZone)(nil), "quicksilver.interchainstaking.v1.DelegationPlansForZone")
+	proto.RegisterMapType((map[string]*DelegationPlan)(nil), "quicksilver.interchainstaking.v1.DelegationPlansForZone.DelegationPlansEntry")
+	proto.RegisterType((*DelegatorIntentsForZone)(nil), "quicksilver.interchainstaking.v1.DelegatorIntentsForZone")
+	proto.RegisterType((*ZoneFees)(nil), "quicksilver.interchainstaking.v1.ZoneFees")
+	proto.RegisterType((*ValsetPass)(nil), "quicksilver.interchainstaking.v1.ValsetPass")
+	proto.RegisterType((*GenesisState)(nil), "quicksilver.interchainstaking.v1.GenesisState")
 }
 
-func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BalanceWaitgroup != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BalanceWaitgroup))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.PortName) > 0 {
-		i -= len(m.PortName)
-		copy(dAtA[i:], m.PortName)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortName)))
-		i--
-		dAtA[i] = 0x22
+func init() {
+	proto.RegisterFile("quicksilver/interchainstaking/v1/genesis.proto", fileDescriptor_196cdf77e041fc72)
+}
+
+var fileDescriptor_196cdf77e041fc72 = []byte{
+	// 3167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x6c, 0x24, 0xc5,
+	0xf5, 0xf7, 0x7c, 0x78, 0x3c, 0x7e, 0xb6, 0xc7, 0xe3, 0xb2, 0xd7, 0xdb, 0x6b, 0xc0, 0xb6, 0xe6,
+	0x2f, 0xfe, 0x18, 0xf8, 0xef, 0x98, 0x35, 0xfc, 0xc9, 0x06, 0x45, 0x51, 0xfc, 0xb1, 0x0b, 0x16,
+	0x2c, 0x98, 0xf6, 0x2e, 0x44, 0x24, 0xd0, 0xaa, 0xe9, 0x2e, 0xcf, 0x14, 0xdb, 0x5d, 0xdd, 0xdb,
+	0x55, 0x3d, 0xb6, 0x57, 0x91, 0x72, 0x43, 0x91, 0x72, 0x21, 0x97, 0x28, 0x47, 0x24, 0x6e, 0x39,
+	0xe5, 0x80, 0x22, 0xe5, 0xce, 0x81, 0x13, 0x42, 0xe4, 0x12, 0x45, 0x11, 0x44, 0xa0, 0x48, 0xb9,
+	0x44, 0x4a, 0x72, 0x8e, 0x94, 0xa8, 0xaa, 0xab, 0x3f, 0x66, 0x6c, 0x3c, 0x33, 0x96, 0x4d, 0x2e,
+	0xf6, 0xd4, 0x7b, 0xf5, 0x7e, 0xaf, 0xfa, 0x55, 0xd5, 0xfb, 0xa8, 0x2a, 0x68, 0x3e, 0x88, 0xa8,
+	0x7d, 0x9f, 0x53, 0xb7, 0x4b, 0xc2, 0x75, 0xca, 0x04, 0x09, 0xed, 0x0e, 0xa6, 0x8c, 0x0b, 0x7c,
+	0x9f, 0xb2, 0xf6, 0x7a, 0xf7, 0xc6, 0x7a, 0x9b, 0x30, 0xc2, 0x29, 0x6f, 0x06, 0xa1, 0x2f, 0x7c,
+	0xb4, 0x9a, 0xeb, 0xdf, 0x3c, 0xd1, 0xbf, 0xd9, 0xbd, 0xb1, 0xb4, 0xd0, 0xf6, 0xdb, 0xbe, 0xea,
+	0xbc, 0x2e, 0x7f, 0xc5, 0x72, 0x4b, 0xd7, 0x6c, 0x9f, 0x7b, 0x3e, 0xb7, 0x62, 0x46, 0xdc, 0xd0,
+	0xac, 0xe5, 0xb8, 0xb5, 0xde, 0xc2, 0x9c, 0xac, 0x77, 0x6f, 0xb4, 0x88, 0xc0, 0x37, 0xd6, 0x6d,
+	0x9f, 0x32, 0xcd, 0x5f, 0x69, 0xfb, 0x7e, 0xdb, 0x25, 0xeb, 0xaa, 0xd5, 0x8a, 0x0e, 0xd6, 0x05,
+	0xf5, 0x08, 0x17, 0xd8, 0x0b, 0xe2, 0x0e, 0x8d, 0x4f, 0xe7, 0xa1, 0x66, 0x92, 0x36, 0xe5, 0x82,
+	0x84, 0xc4, 0x79, 0xcb, 0x67, 0x04, 0xfd, 0x0f, 0xcc, 0xd8, 0x3e, 0x63, 0xc4, 0x16, 0xd4, 0x67,
+	0x16, 0x75, 0x8c, 0xc2, 0x6a, 0x61, 0x6d, 0xd2, 0x9c, 0xce, 0x88, 0xbb, 0x0e, 0xba, 0x06, 0x55,
+	0x35, 0x78, 0xc9, 0x2f, 0x2a, 0xfe, 0x84, 0x6a, 0xef, 0x3a, 0xe8, 0x1e, 0xcc, 0x3a, 0x24, 0xf0,
+	0x39, 0x15, 0x16, 0x76, 0x9c, 0x90, 0x70, 0x6e, 0x94, 0x56, 0x0b, 0x6b, 0x53, 0x1b, 0xff, 0xd7,
+	0x1c, 0x64, 0x80, 0xe6, 0xee, 0xf6, 0xe6, 0xa6, 0x6d, 0xfb, 0x11, 0x13, 0x66, 0x4d, 0x83, 0x6c,
+	0xc6, 0x18, 0xe8, 0x47, 0x80, 0x0e, 0xa9, 0xe8, 0x38, 0x21, 0x3e, 0xc4, 0x6e, 0x8a, 0x5c, 0x3e,
+	0x07, 0xf2, 0x5c, 0x86, 0x93, 0x80, 0xbf, 0x0d, 0xf3, 0x01, 0x09, 0x0f, 0xfc, 0xd0, 0xc3, 0xcc,
+	0x26, 0x29, 0xfa, 0xf8, 0x39, 0xd0, 0x51, 0x0e, 0x28, 0x81, 0xb7, 0x60, 0xc1, 0x21, 0x2e, 0x69,
+	0x63, 0x65, 0x52, 0x8d, 0x4e, 0xb8, 0x51, 0x59, 0x2d, 0x8d, 0x8c, 0x3f, 0x9f, 0x21, 0x6d, 0x26,
+	0x40, 0xe8, 0x71, 0xa8, 0xe1, 0x98, 0x6f, 0x05, 0x21, 0x39, 0xa0, 0x47, 0xc6, 0x84, 0x9a, 0x94,
+	0x19, 0x4d, 0xdd, 0x53, 0x44, 0xb4, 0x02, 0x53, 0xae, 0x6f, 0x63, 0xd7, 0x72, 0x08, 0xf3, 0x3d,
+	0xa3, 0xaa, 0xfa, 0x80, 0x22, 0xed, 0x48, 0x0a, 0x7a, 0x0c, 0x40, 0x2e, 0x25, 0xcd, 0x9f, 0x54,
+	0xfc, 0x49, 0x49, 0x89, 0xd9, 0x04, 0x66, 0x43, 0xe2, 0x10, 0x2f, 0x50, 0xdf, 0x11, 0x62, 0x41,
+	0x0c, 0x90, 0x7d, 0xb6, 0xbe, 0xf7, 0xc9, 0x17, 0x2b, 0x63, 0x7f, 0xfc, 0x62, 0xe5, 0x7f, 0xdb,
+	0x54, 0x74, 0xa2, 0x56, 0xd3, 0xf6, 0x3d, 0xbd, 0x50, 0xf5, 0xbf, 0xeb, 0xdc, 0xb9, 0xbf, 0x2e,
+	0x8e, 0x03, 0xc2, 0x9b, 0x3b, 0xc4, 0xfe, 0xfc, 0xa3, 0xeb, 0x10, 0xd3, 0x65, 0xcb, 0xac, 0x65,
+	0xa0, 0x26, 0x16, 0x04, 0x31, 0x58, 0x70, 0x31, 0x17, 0x56, 0xbf, 0xae, 0xa9, 0x0b, 0xd0, 0x85,
+	0x24, 0xb2, 0xd9, 0xab, 0xef, 0x65, 0x80, 0x2e, 0x76, 0xa9, 0x83, 0x85, 0x1f, 0x72, 0x63, 0x5a,
+	0x4d, 0xca, 0xd3, 0x83, 0x27, 0xe5, 0x8d, 0x44, 0xc6, 0xcc, 0x89, 0xa3, 0x00, 0xea, 0xb8, 0xdd,
+	0x0e, 0xe5, 0x14, 0x11, 0x4b, 0xca, 0x31, 0x61, 0xcc, 0x28, 0xc8, 0x5b, 0x83, 0x21, 0x7b, 0xb7,
+	0x62, 0x73, 0x33, 0x01, 0xda, 0x55, 0x38, 0xb7, 0x98, 0x08, 0x8f, 0xcd, 0x59, 0xdc, 0x4b, 0x95,
+	0x93, 0xe6, 0x45, 0xae, 0xa0, 0x16, 0x27, 0xcc, 0x31, 0x6a, 0xab, 0x85, 0xb5, 0xaa, 0x39, 0xa9,
+	0x28, 0xfb, 0x84, 0x39, 0xe8, 0x49, 0xa8, 0xbb, 0xf4, 0x41, 0x44, 0x1d, 0x2a, 0x8e, 0x2d, 0xcf,
+	0x77, 0x22, 0x97, 0x18, 0xb3, 0xaa, 0xd3, 0x6c, 0x4a, 0xbf, 0xa3, 0xc8, 0xe8, 0x06, 0x2c, 0xe4,
+	0xf6, 0xd8, 0x21, 0xa6, 0xa2, 0x1d, 0xfa, 0x51, 0x60, 0xd4, 0x57, 0x0b, 0x6b, 0x33, 0xe6, 0x7c,
+	0xc6, 0x7b, 0x33, 0x61, 0xa1, 0xef, 0x80, 0x41, 0x5b, 0xb6, 0xc5, 0xc8, 0x91, 0xb0, 0x32, 0x2b,
+	0x58, 0x1d, 0xcc, 0x3b, 0xc6, 0xdc, 0x6a, 0x61, 0x6d, 0xda, 0xbc, 0x42, 0x5b, 0xf6, 0xab, 0xe4,
+	0x48, 0xa4, 0xe6, 0xe2, 0x2f, 0x61, 0xde, 0x41, 0xbf, 0x28, 0xc0, 0x72, 0x2a, 0x60, 0x71, 0xe2,
+	0x6a, 0x87, 0x83, 0x5d, 0xb9, 0x1e, 0xe5, 0x4f, 0x03, 0x29, 0xb3, 0x5d, 0x6b, 0xea, 0xe9, 0x93,
+	0xeb, 0xb0, 0xa9, 0x9d, 0x5c, 0x73, 0xdb, 0xa7, 0x6c, 0xeb, 0x19, 0xb9, 0x14, 0x7e, 0xfd, 0xe5,
+	0xca, 0xda, 0x10, 0x4b, 0x41, 0x0a, 0x70, 0xf3, 0xd1, 0x54, 0xe5, 0x7e, 0xa2, 0x71, 0x33, 0x55,
+	0x88, 0x7e, 0x02, 0xf3, 0x1d, 0xdf, 0x75, 0x28, 0x6b, 0xf3, 0xfc, 0x38, 0xe6, 0x2f, 0x7e, 0x1c,
+	0x28, 0xd1, 0x93, 0xd3, 0xfe, 0x38, 0xd4, 0x1c, 0x72, 0x40, 0xc2, 0x90, 0x38, 0x16, 0x09, 0x7c,
+	0xbb, 0x63, 0x2c, 0xac, 0x16, 0xd6, 0x4a, 0xe6, 0x4c, 0x42, 0xbd, 0x25, 0x89, 0x68, 0x19, 0x80,
+	0x47, 0x8c, 0x13, 0x21, 0x28, 0x6b, 0x1b, 0x57, 0xd4, 0x4c, 0xe6, 0x28, 0xe8, 0x75, 0x98, 0x8b,
+	0x5b, 0x96, 0xed, 0x7b, 0x81, 0x4b, 0xd4, 0x27, 0x2c, 0x2a, 0x4f, 0xb6, 0xd4, 0x8c, 0xe3, 0x41,
+	0x33, 0x89, 0x07, 0xcd, 0xbb, 0x49, 0x3c, 0xd8, 0xaa, 0xca, 0x6f, 0x78, 0xff, 0xcb, 0x95, 0x82,
+	0x59, 0x8f, 0xc5, 0xb7, 0x53, 0x69, 0xb9, 0xef, 0x6d, 0xdf, 0xf3, 0x28, 0xe7, 0xe9, 0x5e, 0xbc,
+	0x7a, 0x11, 0xfb, 0x3e, 0x03, 0x55, 0xfb, 0xf0, 0x18, 0x96, 0x3c, 0x7c, 0xd4, 0xbf, 0xed, 0x2d,
+	0xbb, 0x83, 0x59, 0x9b, 0x18, 0xc6, 0x05, 0x68, 0xbc, 0xea, 0xe1, 0xa3, 0xde, 0xcd, 0xbf, 0xad,
+	0xc0, 0x91, 0x0b, 0xf3, 0x1e, 0x65, 0x27, 0x3c, 0xce, 0xb5, 0x0b, 0xd0, 0x39, 0xe7, 0x51, 0xd6,
+	0xe7, 0x70, 0xa4, 0xb6, 0x93, 0x1f, 0x6a, 0x2c, 0x5d, 0x88, 0xb6, 0xfe, 0x2f, 0x44, 0xcf, 0xc3,
+	0x55, 0x9b, 0x86, 0x76, 0x44, 0x85, 0xd5, 0x0a, 0x09, 0xbe, 0x4f, 0x42, 0x4b, 0x84, 0x34, 0x08,
+	0x88, 0x63, 0x3c, 0xa2, 0x56, 0xcf, 0x15, 0xcd, 0xde, 0x8a, 0xb9, 0x77, 0x63, 0x26, 0xda, 0x84,
+	0x71, 0x2e, 0xe4, 0xb8, 0x1e, 0x5d, 0x2d, 0xac, 0xd5, 0x86, 0xf1, 0x88, 0xd2, 0x69, 0xed, 0x4b,
+	0x11, 0x33, 0x96, 0x44, 0xd7, 0x01, 0x65, 0x7b, 0xdc, 0x21, 0xec, 0xd8, 0xa5, 0x5c, 0x18, 0x8f,
+	0xad, 0x96, 0xd6, 0x26, 0xcd, 0xb9, 0x94, 0xb3, 0xa3, 0x19, 0x68, 0x1d, 0xe6, 0xb3, 0xee, 0x72,
+	0x03, 0x1e, 0xaa, 0xfe, 0xcb, 0xaa, 0x7f, 0x86, 0xb4, 0x99, 0x70, 0xd0, 0x4d, 0x30, 0xf2, 0x81,
+	0x55, 0x87, 0x40, 0xf5, 0xd7, 0x58, 0x51, 0x4e, 0x6b, 0x31, 0x17, 0x2e, 0x63, 0xf6, 0xb6, 0xfc,
+	0x83, 0x2c, 0x98, 0x0e, 0xc9, 0x21, 0x0e, 0x1d, 0x6e, 0x39, 0x11, 0x17, 0xc6, 0xea, 0xc8, 0xb6,
+	0xdf, 0x65, 0x22, 0x67, 0xfb, 0x5d, 0x26, 0xcc, 0x29, 0x8d, 0xb8, 0x13, 0x71, 0xb1, 0x14, 0xc1,
+	0xc2, 0x69, 0xee, 0x1b, 0xd5, 0xa1, 0x74, 0x9f, 0x1c, 0xeb, 0xa4, 0x4a, 0xfe, 0x44, 0x2f, 0xc2,
+	0x78, 0x17, 0xbb, 0x11, 0x51, 0x89, 0xd4, 0xd4, 0xc6, 0x8d, 0x11, 0x22, 0x4f, 0x0c, 0x6c, 0xc6,
+	0xf2, 0x2f, 0x14, 0x6f, 0x16, 0x1a, 0xbf, 0x2d, 0x01, 0x64, 0xd9, 0x02, 0xda, 0x80, 0x89, 0x24,
+	0x99, 0x51, 0x1a, 0xb7, 0x8c, 0xcf, 0x3f, 0xba, 0xbe, 0xa0, 0xc7, 0xac, 0xf3, 0x87, 0x7d, 0x11,
+	0x52, 0xd6, 0x36, 0x93, 0x8e, 0x88, 0xc0, 0x44, 0x0b, 0xbb, 0x98, 0xd9, 0x72, 0x44, 0x17, 0xee,
+	0xf9, 0x12, 0x6c, 0xf4, 0x5e, 0x01, 0xe6, 0xf4, 0xe4, 0x10, 0xc7, 0x4a, 0x34, 0xc6, 0xa9, 0xe2,
+	0x19, 0x1a, 0xbf, 0xaf, 0xa7, 0xe8, 0x89, 0x21, 0x35, 0x7e, 0xfe, 0xd1, 0xf5, 0x29, 0x0d, 0x26,
+	0x9b, 0x66, 0x3d, 0xd5, 0xb9, 0xa5, 0x07, 0xf2, 0x08, 0x4c, 0x06, 0x7e, 0x28, 0x2c, 0x86, 0x3d,
+	0xa2, 0x12, 0xca, 0x49, 0xb3, 0x2a, 0x09, 0xaf, 0x62, 0x8f, 0xa0, 0xa7, 0x61, 0x4e, 0x0f, 0x2d,
+	0x17, 0x0f, 0xc7, 0xd5, 0xd2, 0xaa, 0x6b, 0x46, 0x16, 0x0c, 0x57, 0x61, 0x2a, 0x62, 0xb8, 0x8b,
+	0xa9, 0x8b, 0x5b, 0x2e, 0x31, 0x2a, 0x6a, 0x77, 0xe5, 0x49, 0xc8, 0x80, 0x89, 0x90, 0x08, 0x1a,
+	0x12, 0x47, 0x65, 0x68, 0x55, 0x33, 0x69, 0x36, 0x7e, 0x3e, 0x0e, 0xf5, 0x37, 0xd3, 0x00, 0x6b,
+	0x12, 0xdb, 0x0f, 0x1d, 0xf4, 0x3c, 0x4c, 0xea, 0xe1, 0xfa, 0xe1, 0xc0, 0x09, 0xcc, 0xba, 0x4a,
+	0xb9, 0x74, 0xb7, 0x18, 0xc5, 0x41, 0x72, 0x69, 0x57, 0x29, 0x17, 0x12, 0x9b, 0x06, 0x54, 0x66,
+	0x2d, 0xa5, 0x41, 0x72, 0x69, 0x57, 0xf4, 0x00, 0x2a, 0xd8, 0x53, 0xbb, 0xae, 0x7c, 0xd9, 0xf3,
+	0xa7, 0x15, 0xa1, 0x87, 0x30, 0xd5, 0x8a, 0x42, 0x66, 0x69, 0xbd, 0xe3, 0x97, 0xad, 0x17, 0xa4,
+	0xb6, 0xcd, 0x58, 0xf7, 0x22, 0x54, 0xc4, 0x91, 0x4a, 0x71, 0x2a, 0x6a, 0xb9, 0xe8, 0x16, 0xda,
+	0x83, 0x0a, 0x17, 0x58, 0x44, 0x5c, 0x4d, 0x6e, 0x6d, 0xe3, 0xe6, 0xe0, 0xad, 0xdc, 0x3f, 0xe5,
+	0xfb, 0x4a, 0xde, 0xd4, 0x38, 0xe8, 0x8e, 0x8a, 0xbc, 0x3a, 0x0e, 0x5b, 0xb2, 0x7a, 0x33, 0xaa,
+	0x23, 0x84, 0xf2, 0x5a, 0x26, 0x2c, 0xd9, 0xe8, 0x09, 0x98, 0x8d, 0x58, 0xcb, 0x67, 0x32, 0xf5,
+	0xb0, 0x5a, 0x58, 0xd8, 0x1d, 0x9d, 0xe4, 0xd7, 0x52, 0xf2, 0x96, 0xa4, 0x36, 0xfe, 0x52, 0x84,
+	0x7a, 0x16, 0x46, 0x6e, 0x71, 0x3b, 0xf4, 0x0f, 0x7b, 0x8a, 0xbe, 0x42, 0x6f, 0xd1, 0x97, 0x59,
+	0xa4, 0xd8, 0x63, 0x91, 0xe7, 0xa0, 0x2a, 0xa3, 0x1c, 0xf1, 0x48, 0x38, 0x70, 0x3d, 0xa5, 0x3d,
+	0xff, 0x1b, 0xcb, 0x29, 0x92, 0x03, 0x3d, 0x88, 0x98, 0x43, 0x9c, 0xcb, 0x5f, 0x4b, 0xa9, 0xaa,
+	0xc6, 0xdf, 0x0a, 0x50, 0xbb, 0x1b, 0x62, 0xc6, 0x0f, 0x48, 0xa8, 0xf7, 0xfc, 0x33, 0x50, 0xe1,
+	0x84, 0x39, 0x64, 0xf0, 0x86, 0xd7, 0xfd, 0x7a, 0x77, 0x6d, 0xf1, 0x3c, 0xbb, 0xb6, 0xf4, 0x2d,
+	0x99, 0xb9, 0xf1, 0x59, 0x19, 0x26, 0xd3, 0xe8, 0x85, 0x36, 0x61, 0xb6, 0x8b, 0x5d, 0x3f, 0x20,
+	0xa1, 0x35, 0x6c, 0x94, 0xaa, 0x69, 0x81, 0xcd, 0x34, 0x58, 0x9d, 0x48, 0x4d, 0x8b, 0x97, 0x90,
+	0x9a, 0xb6, 0xa1, 0x9e, 0x7a, 0x57, 0x8b, 0x77, 0x70, 0x48, 0xb8, 0x51, 0xba, 0x00, 0x3d, 0xb3,
+	0x29, 0xea, 0xbe, 0x02, 0x95, 0x79, 0x49, 0xd7, 0x97, 0x79, 0xbc, 0x15, 0xf8, 0x87, 0x24, 0x34,
+	0xca, 0x23, 0x2b, 0x39, 0x25, 0x2f, 0x89, 0x11, 0xf7, 0x24, 0x20, 0x32, 0x61, 0x9c, 0xdb, 0x7e,
+	0x48, 0x8c, 0xf1, 0x91, 0x91, 0x4f, 0x0e, 0x3f, 0x86, 0x92, 0xbb, 0x5f, 0xfb, 0x3d, 0xed, 0x0f,
+	0xe3, 0x96, 0xa4, 0xbf, 0x8b, 0xa9, 0x9b, 0x06, 0x3b, 0xdd, 0x92, 0x25, 0x8c, 0xf0, 0xbd, 0x16,
+	0x17, 0x3e, 0x23, 0x8e, 0x72, 0x68, 0x55, 0x33, 0x47, 0x91, 0x41, 0xd7, 0xf6, 0x19, 0x27, 0x8c,
+	0x47, 0x3c, 0x5d, 0x19, 0xb1, 0xa3, 0xaa, 0xa7, 0x0c, 0xbd, 0x02, 0x1a, 0xbf, 0x2c, 0xc0, 0xec,
+	0x4e, 0x62, 0x45, 0x5d, 0x12, 0x9f, 0x37, 0x6e, 0xbe, 0x0c, 0x13, 0x71, 0xc9, 0xce, 0x75, 0xea,
+	0x73, 0x8e, 0x64, 0x2c, 0x41, 0x68, 0x7c, 0x5c, 0x80, 0xd9, 0x3e, 0xe6, 0x45, 0xac, 0x78, 0x06,
+	0x95, 0x43, 0x42, 0xdb, 0x9d, 0x64, 0xab, 0xbf, 0x31, 0xda, 0x0c, 0xfe, 0xf3, 0x8b, 0x95, 0xc5,
+	0x63, 0xec, 0xb9, 0x2f, 0x34, 0x42, 0xe2, 0x62, 0x41, 0xbb, 0xc4, 0x8a, 0xe1, 0x1a, 0x7d, 0x73,
+	0x5b, 0x49, 0xc8, 0x45, 0x80, 0x9d, 0x34, 0x89, 0x46, 0x2f, 0x02, 0x3a, 0x79, 0x96, 0x35, 0xf0,
+	0x23, 0xe6, 0x4e, 0x9c, 0x5a, 0xa1, 0x5b, 0x30, 0x97, 0x4b, 0xf6, 0x35, 0xce, 0x20, 0xef, 0x55,
+	0xcf, 0x8a, 0x00, 0x0d, 0xf3, 0xed, 0x3b, 0x31, 0xb9, 0xac, 0x3b, 0xf1, 0x0c, 0x94, 0x55, 0x81,
+	0xae, 0x5b, 0xf2, 0xa4, 0x25, 0x24, 0xd9, 0x87, 0x5a, 0xf2, 0x38, 0x66, 0x5c, 0xf5, 0x98, 0xcd,
+	0xd3, 0x6f, 0x31, 0xa7, 0xb1, 0x0f, 0xf3, 0x7b, 0x7e, 0x28, 0xb6, 0xd3, 0x33, 0xd5, 0xbb, 0x51,
+	0xe0, 0x0e, 0x79, 0xf6, 0x7a, 0x15, 0x26, 0x54, 0xbe, 0x9a, 0x1e, 0xbd, 0x56, 0x64, 0x73, 0xd7,
+	0x69, 0xfc, 0xbe, 0x04, 0x13, 0x26, 0xb1, 0x09, 0x0d, 0x04, 0xda, 0x81, 0xf2, 0x43, 0x9f, 0x11,
+	0x05, 0x30, 0xb5, 0xf1, 0xcc, 0xa8, 0x47, 0x4f, 0xa6, 0x92, 0xce, 0xc5, 0xa2, 0xe2, 0x90, 0xb1,
+	0x28, 0x4b, 0x04, 0x4a, 0x3d, 0x89, 0x80, 0x9d, 0x0b, 0xe9, 0x17, 0x5e, 0x53, 0x24, 0x13, 0x13,
+	0xc0, 0xcc, 0x03, 0xcc, 0xe5, 0xd1, 0x47, 0x9a, 0x15, 0x5e, 0xb8, 0xae, 0xe9, 0x58, 0x83, 0xce,
+	0x04, 0x71, 0x1a, 0x17, 0xe4, 0x84, 0x05, 0x2e, 0x66, 0xc9, 0xa9, 0xee, 0x10, 0x26, 0xcf, 0x76,
+	0xd5, 0x9e, 0x8b, 0xd9, 0x56, 0x59, 0x8e, 0x25, 0x8d, 0x08, 0x9a, 0xca, 0x1b, 0x9f, 0x16, 0xa1,
+	0x62, 0xaa, 0x7c, 0xe1, 0x3c, 0x09, 0xd8, 0x79, 0x33, 0xfa, 0x6f, 0x65, 0xbe, 0x16, 0xa1, 0x12,
+	0x12, 0xcc, 0x7d, 0x16, 0x07, 0x23, 0x53, 0xb7, 0xd0, 0xed, 0x9e, 0x78, 0x52, 0xdb, 0x68, 0x0e,
+	0xb3, 0x7c, 0xa5, 0x85, 0xfa, 0xb2, 0xe7, 0x05, 0x18, 0x8f, 0x93, 0xdc, 0xf8, 0x34, 0x3c, 0x6e,
+	0x34, 0x7e, 0x57, 0x04, 0xd8, 0xdd, 0xda, 0xde, 0x89, 0xef, 0x17, 0xce, 0x32, 0xaa, 0xca, 0x5e,
+	0x6d, 0x42, 0xbb, 0x43, 0x6c, 0x80, 0xb4, 0x67, 0xce, 0x74, 0xa5, 0x4b, 0x35, 0x9d, 0x3e, 0x5c,
+	0x8e, 0x2b, 0xd6, 0x0a, 0x4d, 0x0f, 0x83, 0xe5, 0x79, 0x19, 0x23, 0xae, 0xfc, 0x9e, 0xd8, 0xac,
+	0x93, 0x9a, 0xb2, 0xeb, 0xa0, 0x25, 0xa8, 0x72, 0xf2, 0x20, 0x22, 0xb2, 0xd4, 0x96, 0xb6, 0x2d,
+	0x9b, 0x69, 0x1b, 0x35, 0x60, 0x1a, 0xdb, 0xf7, 0x99, 0x7f, 0xe8, 0x12, 0xa7, 0x9d, 0xc6, 0xec,
+	0x1e, 0x5a, 0xe3, 0xef, 0x45, 0xa8, 0xef, 0xbb, 0x98, 0x77, 0x28, 0x6b, 0xef, 0x32, 0x9b, 0x3a,
+	0x84, 0x9d, 0x69, 0xc1, 0xf3, 0x16, 0xa2, 0x99, 0x8b, 0x2d, 0xf5, 0xb8, 0xd8, 0x9b, 0x50, 0x56,
+	0x45, 0x50, 0x79, 0x84, 0x22, 0x48, 0x49, 0xa0, 0x1f, 0x42, 0xf5, 0x20, 0xc4, 0xca, 0x87, 0x5e,
+	0x48, 0xea, 0x93, 0xa2, 0xa1, 0xb7, 0x61, 0x4a, 0xf8, 0xf7, 0x09, 0xe3, 0x96, 0xeb, 0x73, 0x61,
+	0x54, 0x46, 0x06, 0x3f, 0x99, 0xb1, 0x41, 0x0c, 0xf8, 0x8a, 0xcf, 0x45, 0xe3, 0xe3, 0x22, 0x4c,
+	0xef, 0xda, 0xf8, 0xb5, 0x80, 0x84, 0x71, 0x04, 0x3e, 0xc3, 0xdc, 0xdf, 0x14, 0x1a, 0xfa, 0x96,
+	0x45, 0xe9, 0xac, 0x65, 0x51, 0xee, 0x5b, 0x16, 0x08, 0xca, 0x1e, 0xf1, 0x7c, 0xbd, 0x96, 0xd4,
+	0x6f, 0x49, 0x73, 0xb0, 0xc0, 0xea, 0x5b, 0xa7, 0x4d, 0xf5, 0x5b, 0x62, 0x60, 0x21, 0x64, 0xc5,
+	0x18, 0x97, 0xbf, 0x33, 0x66, 0xda, 0x96, 0x47, 0x2c, 0x1e, 0x6f, 0x5b, 0xea, 0x73, 0x8d, 0xaa,
+	0x3a, 0xce, 0xab, 0x7a, 0xbc, 0x7d, 0x57, 0xb6, 0x73, 0x73, 0x3d, 0xd9, 0x33, 0xd7, 0xaf, 0xa4,
+	0x5e, 0x00, 0x94, 0x17, 0x78, 0x6e, 0x88, 0x7b, 0xb2, 0x9c, 0x9d, 0x7a, 0x7d, 0x41, 0xe3, 0xdf,
+	0x05, 0xa8, 0xf5, 0x3a, 0x5c, 0xb4, 0x03, 0x27, 0xd2, 0x89, 0x81, 0x89, 0xcc, 0x09, 0x09, 0x89,
+	0x92, 0x26, 0x90, 0x9b, 0xc3, 0xa6, 0x31, 0xfd, 0x12, 0x08, 0x27, 0x87, 0x80, 0x97, 0xe0, 0x33,
+	0x62, 0xe4, 0xc6, 0xbf, 0xc6, 0xa1, 0xb2, 0x87, 0x43, 0xec, 0xf1, 0x33, 0xcf, 0x4d, 0x0b, 0x6a,
+	0xfe, 0xbf, 0xe9, 0xdc, 0xf4, 0x74, 0x49, 0x1e, 0xb8, 0x34, 0xce, 0x47, 0x4f, 0x93, 0xdc, 0x97,
+	0x5c, 0x99, 0x1d, 0x25, 0xf7, 0xc2, 0x6a, 0xee, 0xba, 0xd8, 0x55, 0x0b, 0xb1, 0x6c, 0x26, 0xf7,
+	0xc5, 0xbb, 0x9a, 0x2c, 0xf3, 0x7f, 0x0d, 0x42, 0xb2, 0xbe, 0xf1, 0xba, 0x4c, 0x2c, 0x47, 0xd2,
+	0xce, 0x37, 0xf2, 0x97, 0xab, 0x3c, 0xeb, 0x3f, 0xae, 0xfa, 0xe7, 0xae, 0x4b, 0x79, 0x2a, 0xf2,
+	0x2c, 0x5c, 0x49, 0xa7, 0x51, 0x66, 0x0b, 0xa9, 0x4c, 0xec, 0x12, 0x17, 0xf2, 0xcc, 0x54, 0xe8,
+	0x94, 0x4a, 0x73, 0xe2, 0x12, 0x2a, 0xcd, 0x08, 0x0c, 0x49, 0x89, 0x98, 0xbc, 0xae, 0x0b, 0x7c,
+	0xdf, 0xb5, 0x0e, 0x08, 0x89, 0x4b, 0x4e, 0xa3, 0x7a, 0x01, 0xfa, 0xae, 0xa4, 0xe8, 0x7b, 0xbe,
+	0xef, 0xde, 0x26, 0x44, 0x15, 0x9e, 0xe8, 0x5d, 0x40, 0x42, 0x46, 0xdf, 0x28, 0x3c, 0xce, 0x29,
+	0x9c, 0xbc, 0x00, 0x85, 0xf5, 0x04, 0x37, 0xd5, 0xb5, 0x0d, 0x29, 0x2d, 0x4d, 0xfc, 0x61, 0xc0,
+	0x8e, 0x99, 0x4d, 0x24, 0x92, 0x0d, 0xf3, 0x38, 0xcc, 0xca, 0x3b, 0x14, 0x8f, 0xb7, 0xb9, 0x25,
+	0xcb, 0x29, 0x71, 0xa4, 0xee, 0x87, 0xcb, 0xe6, 0xb4, 0x87, 0x8f, 0xee, 0xf0, 0x36, 0xdf, 0x23,
+	0xe1, 0xdd, 0xa3, 0x17, 0xaa, 0xbf, 0xfa, 0x60, 0x65, 0xec, 0xaf, 0x1f, 0xac, 0x14, 0x1a, 0x3f,
+	0x05, 0x94, 0xed, 0x7f, 0x7e, 0xdb, 0x0f, 0xd5, 0x6b, 0x87, 0x33, 0x9c, 0xe9, 0xab, 0x30, 0x95,
+	0x5b, 0x3c, 0x46, 0x71, 0xd8, 0xcb, 0xfa, 0x4c, 0x8b, 0x99, 0x07, 0x68, 0x7c, 0x58, 0x84, 0xc5,
+	0x5e, 0x0f, 0x34, 0xcc, 0x28, 0x8e, 0x4e, 0xc9, 0x30, 0xe3, 0xa1, 0xdc, 0x19, 0x35, 0xc3, 0x4c,
+	0xd4, 0xf5, 0x93, 0xf5, 0xbd, 0x72, 0x5f, 0xe2, 0xb9, 0x24, 0x60, 0xe1, 0xb4, 0x8e, 0xa7, 0xdc,
+	0x60, 0xdc, 0xee, 0xbd, 0xc1, 0x18, 0x39, 0xf5, 0xcd, 0x5f, 0x60, 0xfc, 0xa6, 0x00, 0x57, 0xfb,
+	0xca, 0xf9, 0x61, 0xcc, 0xf4, 0x0e, 0xe4, 0x4a, 0xcc, 0xe4, 0xde, 0x7d, 0xe8, 0x1a, 0xbe, 0x4f,
+	0xa1, 0x99, 0x33, 0x79, 0x4c, 0x51, 0x11, 0x92, 0xe1, 0x80, 0x77, 0xfc, 0x38, 0x25, 0xa9, 0x9a,
+	0x69, 0xbb, 0xf1, 0x5e, 0x01, 0xaa, 0x72, 0x7c, 0xb7, 0x09, 0xe1, 0x67, 0x8d, 0xd1, 0x82, 0xf2,
+	0x01, 0x21, 0xfc, 0x32, 0x6e, 0x55, 0x14, 0x70, 0xc3, 0x02, 0x78, 0x03, 0xbb, 0x9c, 0x88, 0x3d,
+	0xcc, 0xf9, 0x80, 0x6a, 0x41, 0x87, 0xd6, 0x62, 0xcf, 0x81, 0xcd, 0x72, 0xcf, 0x4b, 0x88, 0x92,
+	0x0a, 0xd4, 0x39, 0x4a, 0xe3, 0x1f, 0x00, 0xd3, 0x2f, 0xc6, 0x8f, 0x9a, 0xd4, 0x3d, 0x9f, 0xcc,
+	0xd4, 0x03, 0x15, 0x52, 0x74, 0xa1, 0xb9, 0x36, 0xd8, 0xd6, 0x71, 0x08, 0xd2, 0xd5, 0x8e, 0x96,
+	0x46, 0xaf, 0xc0, 0xb8, 0x2c, 0x38, 0x13, 0xdb, 0x8c, 0x5c, 0xaf, 0x6a, 0xb8, 0x18, 0x04, 0xbd,
+	0xac, 0xf3, 0xf6, 0x40, 0xc4, 0x1f, 0x31, 0xb5, 0xf1, 0xe4, 0x30, 0x80, 0x4a, 0x42, 0x23, 0xa5,
+	0x00, 0xe8, 0xc7, 0xbd, 0x6e, 0x20, 0x2e, 0x87, 0x9e, 0x1b, 0x65, 0x89, 0x27, 0xeb, 0x57, 0x43,
+	0xe7, 0xe1, 0x10, 0x3d, 0x65, 0x7b, 0xc7, 0x55, 0xeb, 0xcd, 0xf3, 0x6e, 0xef, 0x6f, 0x28, 0x24,
+	0x91, 0x9b, 0x6e, 0x11, 0x3f, 0xb4, 0x92, 0x63, 0xae, 0xb8, 0x58, 0xfd, 0xee, 0xc8, 0x5b, 0xa4,
+	0x4f, 0x59, 0xdd, 0xe9, 0x63, 0xa3, 0x03, 0xa8, 0xab, 0x54, 0x34, 0x3b, 0xba, 0x90, 0x69, 0xa1,
+	0x54, 0xf6, 0xff, 0x43, 0xac, 0x91, 0x93, 0x67, 0x23, 0xc9, 0x57, 0x05, 0x3d, 0x2c, 0x8e, 0xda,
+	0x3d, 0xef, 0xc2, 0x42, 0x75, 0x86, 0x1e, 0xe7, 0x98, 0x53, 0x1b, 0x1b, 0xa3, 0xdf, 0xbf, 0x68,
+	0x35, 0x73, 0x87, 0x7d, 0x74, 0x8e, 0x5e, 0x92, 0x57, 0x77, 0xb2, 0xc8, 0x94, 0x47, 0x91, 0xa5,
+	0xe1, 0xd6, 0x7a, 0x5c, 0x95, 0x6a, 0xcc, 0x44, 0x1c, 0xdd, 0x83, 0x69, 0xf9, 0x66, 0x46, 0x67,
+	0x3d, 0x32, 0xf6, 0x0d, 0xfb, 0x0c, 0x2c, 0xad, 0x5a, 0x93, 0xa5, 0x44, 0x5b, 0xb6, 0xa6, 0x28,
+	0x4b, 0x70, 0x5d, 0x9a, 0x59, 0x54, 0xd7, 0x66, 0xdc, 0x98, 0x1a, 0xd6, 0x12, 0xfd, 0x65, 0x5d,
+	0x62, 0x09, 0xde, 0x47, 0x97, 0x4f, 0xf1, 0x6a, 0xd4, 0xc6, 0x96, 0x9f, 0x64, 0xda, 0xc9, 0x9b,
+	0xa9, 0xe6, 0x68, 0x09, 0xba, 0x56, 0x30, 0x43, 0x73, 0x34, 0x79, 0xe3, 0x35, 0x29, 0x37, 0xb1,
+	0xa5, 0x3c, 0x65, 0xfc, 0x70, 0xea, 0xa9, 0xe1, 0x5e, 0x1e, 0x48, 0xf7, 0x9b, 0xec, 0xde, 0x87,
+	0xba, 0x2d, 0x8d, 0x92, 0x7b, 0x66, 0x41, 0xd4, 0x45, 0x16, 0x37, 0x6a, 0xc3, 0x1a, 0xa5, 0xff,
+	0x0e, 0x2c, 0x31, 0x4a, 0xd8, 0x47, 0xe7, 0xe8, 0x4d, 0x98, 0xe9, 0x2a, 0xdf, 0x6b, 0x05, 0x58,
+	0x3d, 0xee, 0x9b, 0x1d, 0x76, 0x56, 0x33, 0x97, 0xad, 0xd1, 0xa7, 0xbb, 0x29, 0x85, 0xf0, 0xa7,
+	0x8e, 0x60, 0x32, 0x7d, 0x57, 0x81, 0xe6, 0x61, 0x36, 0x6d, 0x6c, 0xda, 0x82, 0x76, 0x49, 0x7d,
+	0x0c, 0x3d, 0x02, 0x57, 0x53, 0x62, 0xb2, 0x1a, 0xf6, 0x70, 0xc4, 0x89, 0x53, 0x2f, 0xa0, 0x65,
+	0x58, 0x4a, 0x99, 0xd9, 0xd7, 0x24, 0xfc, 0x62, 0x0f, 0xa2, 0x26, 0x96, 0x96, 0xca, 0x3f, 0xfb,
+	0x70, 0x79, 0xec, 0xa9, 0x3f, 0x15, 0x60, 0xf1, 0xf4, 0xfb, 0x49, 0xf4, 0x18, 0x5c, 0x4b, 0x38,
+	0x31, 0xe5, 0x1e, 0xe3, 0x01, 0xb1, 0xe9, 0x01, 0x25, 0x4e, 0x7d, 0x0c, 0x2d, 0xc1, 0x62, 0x2f,
+	0xfb, 0xae, 0xac, 0x67, 0xe9, 0x43, 0x52, 0x2f, 0xa0, 0x45, 0x40, 0xbd, 0x3c, 0xf9, 0x4a, 0xad,
+	0x5e, 0x44, 0x06, 0x2c, 0xf4, 0xd2, 0x5f, 0x8f, 0x48, 0x24, 0x47, 0x73, 0x92, 0x73, 0x4f, 0x5d,
+	0x56, 0xd6, 0xcb, 0xf2, 0xcb, 0x7b, 0x39, 0xfa, 0xd1, 0x12, 0x71, 0xea, 0xe3, 0x27, 0xc5, 0x6e,
+	0xab, 0xdb, 0x87, 0x7a, 0x45, 0x7f, 0xde, 0x3b, 0x30, 0x9d, 0x3f, 0x35, 0x42, 0x57, 0x61, 0x3e,
+	0xdf, 0xde, 0x23, 0xea, 0x3e, 0xb4, 0x3e, 0x86, 0x16, 0xa0, 0x9e, 0x67, 0xec, 0x13, 0x26, 0xea,
+	0x05, 0x74, 0x0d, 0xae, 0xe4, 0xa9, 0x99, 0xe6, 0xa2, 0xc6, 0x27, 0x80, 0x4e, 0xd6, 0xa3, 0x52,
+	0x4b, 0x9e, 0x9a, 0x69, 0x59, 0xec, 0xed, 0xae, 0x07, 0x5b, 0x90, 0x9f, 0x91, 0xa7, 0xcb, 0x13,
+	0x0e, 0xe7, 0xb5, 0x48, 0x24, 0x6a, 0xb6, 0xde, 0xfa, 0xe4, 0xab, 0xe5, 0xc2, 0x67, 0x5f, 0x2d,
+	0x17, 0xfe, 0xfc, 0xd5, 0x72, 0xe1, 0xfd, 0xaf, 0x97, 0xc7, 0x3e, 0xfb, 0x7a, 0x79, 0xec, 0x0f,
+	0x5f, 0x2f, 0x8f, 0xbd, 0xf5, 0x83, 0x5c, 0xfa, 0x40, 0x59, 0x9b, 0xb0, 0x88, 0x8a, 0xe3, 0xeb,
+	0xad, 0x88, 0xba, 0xce, 0x7a, 0xfe, 0xed, 0xf2, 0xd1, 0x29, 0xaf, 0x97, 0x55, 0x72, 0xd1, 0xaa,
+	0xa8, 0x83, 0x95, 0x67, 0xff, 0x33, 0x00, 0x7e, 0x80, 0x42, 0x2d, 0xeb, 0x2c, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	{
-		size := m.DelegatedBalance.Size()
-		i -= size
-		if _, err := m.DelegatedBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if this.DelegationAccountCount != that1.DelegationAccountCount {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.DelegationAccountSplit != that1.DelegationAccountSplit {
+		return false
+	}
+	if this.DepositInterval != that1.DepositInterval {
+		return false
+	}
+	if this.DelegateInterval != that1.DelegateInterval {
+		return false
+	}
+	if this.DelegationsInterval != that1.DelegationsInterval {
+		return false
+	}
+	if this.ValidatorsetInterval != that1.ValidatorsetInterval {
+		return false
+	}
+	if !this.CommissionRate.Equal(that1.CommissionRate) {
+		return false
+	}
+	if !this.CommunityPoolFeeShare.Equal(that1.CommunityPoolFeeShare) {
+		return false
+	}
+	if !this.TreasuryFeeShare.Equal(that1.TreasuryFeeShare) {
+		return false
+	}
+	if this.TreasuryAddress != that1.TreasuryAddress {
+		return false
+	}
+	if this.MaxMsgsPerTx != that1.MaxMsgsPerTx {
+		return false
+	}
+	return true
 }
-
-func (m *WithdrawalRecord) Marshal() (dAtA []byte, err error) {
+func (m *RegisteredZone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1633,213 +2444,306 @@ func (m *WithdrawalRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WithdrawalRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *RegisteredZone) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RegisteredZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+	{
+		size := m.RewardsDust.Size()
+		i -= size
+		if _, err := m.RewardsDust.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x82
+	if m.DelegationAccountCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegationAccountCount))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
 	}
-	if len(m.Txhash) > 0 {
-		i -= len(m.Txhash)
-		copy(dAtA[i:], m.Txhash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Txhash)))
+	if len(m.ValidatorAllowlist) > 0 {
+		for iNdEx := len(m.ValidatorAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAllowlist[iNdEx])
+			copy(dAtA[i:], m.ValidatorAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.ValidatorDenylist) > 0 {
+		for iNdEx := len(m.ValidatorDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorDenylist[iNdEx])
+			copy(dAtA[i:], m.ValidatorDenylist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.State != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.State))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.CircuitBreakerTripped {
+		i--
+		if m.CircuitBreakerTripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
 	}
 	{
-		size := m.BurnAmount.Size()
+		size := m.MaxRedemptionRate.Size()
 		i -= size
-		if _, err := m.BurnAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
 	{
-		size := m.Amount.Size()
+		size := m.MinRedemptionRate.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	{
+		size := m.MaxRedemptionRateChange.Size()
+		i -= size
+		if _, err := m.MaxRedemptionRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SunsetCompletion, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SunsetCompletion):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.Sunsetting {
 		i--
-		dAtA[i] = 0xa
+		if m.Sunsetting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.DeferredEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DeferredEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.HoldingsAllocation) > 0 {
+		for iNdEx := len(m.HoldingsAllocation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HoldingsAllocation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recipient)))
+	if len(m.ValidatorSelectionAllocation) > 0 {
+		for iNdEx := len(m.ValidatorSelectionAllocation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSelectionAllocation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.IbcNextValidatorsHash) > 0 {
+		i -= len(m.IbcNextValidatorsHash)
+		copy(dAtA[i:], m.IbcNextValidatorsHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.IbcNextValidatorsHash)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sender)))
+	if m.WithdrawalWaitgroup != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WithdrawalWaitgroup))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.LiquidityModule {
+		i--
+		if m.LiquidityModule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Score.Size()
-		i -= size
-		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.MultiSend {
+		i--
+		if m.MultiSend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x70
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.VotingPower.Size()
-		i -= size
-		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.AggregateIntent) > 0 {
+		for k := range m.AggregateIntent {
+			v := m.AggregateIntent[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintGenesis(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
 	{
-		size := m.DelegatorShares.Size()
+		size := m.LastRedemptionRate.Size()
 		i -= size
-		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LastRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x5a
 	{
-		size := m.CommissionRate.Size()
+		size := m.RedemptionRate.Size()
 		i -= size
-		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.ValoperAddress) > 0 {
-		i -= len(m.ValoperAddress)
-		copy(dAtA[i:], m.ValoperAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValoperAddress)))
+	dAtA[i] = 0x52
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BaseDenom)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x4a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DelegatorIntent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.LocalDenom) > 0 {
+		i -= len(m.LocalDenom)
+		copy(dAtA[i:], m.LocalDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LocalDenom)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DelegatorIntent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DelegatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Intents) > 0 {
-		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AccountPrefix) > 0 {
+		i -= len(m.AccountPrefix)
+		copy(dAtA[i:], m.AccountPrefix)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AccountPrefix)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DelegationAddresses) > 0 {
+		for iNdEx := len(m.DelegationAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.DelegationAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1847,20 +2751,63 @@ func (m *DelegatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+	if m.PerformanceAddress != nil {
+		{
+			size, err := m.PerformanceAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.WithdrawalAddress != nil {
+		{
+			size, err := m.WithdrawalAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DepositAddress != nil {
+		{
+			size, err := m.DepositAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorIntent) Marshal() (dAtA []byte, err error) {
+func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1870,37 +2817,83 @@ func (m *ValidatorIntent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorIntent) MarshalTo(dAtA []byte) (int, error) {
+func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Retired {
+		i--
+		if m.Retired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Unavailable {
+		i--
+		if m.Unavailable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BalanceWaitgroup != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BalanceWaitgroup))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PortName) > 0 {
+		i -= len(m.PortName)
+		copy(dAtA[i:], m.PortName)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortName)))
+		i--
+		dAtA[i] = 0x22
+	}
 	{
-		size := m.Weight.Size()
+		size := m.DelegatedBalance.Size()
 		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DelegatedBalance.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.ValoperAddress) > 0 {
-		i -= len(m.ValoperAddress)
-		copy(dAtA[i:], m.ValoperAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValoperAddress)))
+	dAtA[i] = 0x1a
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Delegation) Marshal() (dAtA []byte, err error) {
+func (m *WithdrawalRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1910,26 +2903,53 @@ func (m *Delegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *WithdrawalRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RedelegationEnd != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.RedelegationEnd))
+	if len(m.UnbondingBatch) > 0 {
+		i -= len(m.UnbondingBatch)
+		copy(dAtA[i:], m.UnbondingBatch)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.UnbondingBatch)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x4a
 	}
-	if m.Height != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x42
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x38
+	}
+	if len(m.Txhash) > 0 {
+		i -= len(m.Txhash)
+		copy(dAtA[i:], m.Txhash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Txhash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.BurnAmount.Size()
+		i -= size
+		if _, err := m.BurnAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -1939,25 +2959,32 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.DelegationAddress) > 0 {
-		i -= len(m.DelegationAddress)
-		copy(dAtA[i:], m.DelegationAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegationAddress)))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PortConnectionTuple) Marshal() (dAtA []byte, err error) {
+func (m *RedemptionEscrow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1967,34 +2994,61 @@ func (m *PortConnectionTuple) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PortConnectionTuple) MarshalTo(dAtA []byte) (int, error) {
+func (m *RedemptionEscrow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PortConnectionTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RedemptionEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+	{
+		size := m.Refunded.Size()
+		i -= size
+		if _, err := m.Refunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Redeemer) > 0 {
+		i -= len(m.Redeemer)
+		copy(dAtA[i:], m.Redeemer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Redeemer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txhash) > 0 {
+		i -= len(m.Txhash)
+		copy(dAtA[i:], m.Txhash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Txhash)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Receipt) Marshal() (dAtA []byte, err error) {
+func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2004,60 +3058,44 @@ func (m *Receipt) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Txhash) > 0 {
-		i -= len(m.Txhash)
-		copy(dAtA[i:], m.Txhash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Txhash)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recipient)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
 		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Zone != nil {
-		{
-			size, err := m.Zone.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationPlan) Marshal() (dAtA []byte, err error) {
+func (m *Validator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2067,111 +3105,101 @@ func (m *DelegationPlan) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationPlan) MarshalTo(dAtA []byte) (int, error) {
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		for iNdEx := len(m.Value) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Value[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.Tombstoned {
 		i--
-		dAtA[i] = 0x12
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if m.Jailed {
 		i--
-		dAtA[i] = 0xa
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size := m.CommissionRate.Size()
+		size := m.Score.Size()
 		i -= size
-		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
-	if m.ValidatorsetInterval != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.ValidatorsetInterval))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.DelegationsInterval != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegationsInterval))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.DelegateInterval != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegateInterval))
-		i--
-		dAtA[i] = 0x20
+	dAtA[i] = 0x2a
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.DepositInterval != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.DepositInterval))
-		i--
-		dAtA[i] = 0x18
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.DelegationAccountSplit != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegationAccountSplit))
-		i--
-		dAtA[i] = 0x10
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.DelegationAccountCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegationAccountCount))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValoperAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationsForZone) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorIntent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2181,20 +3209,20 @@ func (m *DelegationsForZone) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationsForZone) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorIntent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationsForZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Intents) > 0 {
+		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2205,17 +3233,17 @@ func (m *DelegationsForZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationPlansForZone) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorIntent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2225,53 +3253,37 @@ func (m *DelegationPlansForZone) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationPlansForZone) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorIntent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationPlansForZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DelegationPlans) > 0 {
-		for k := range m.DelegationPlans {
-			v := m.DelegationPlans[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintGenesis(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValoperAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorIntentsForZone) Marshal() (dAtA []byte, err error) {
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2281,51 +3293,54 @@ func (m *DelegatorIntentsForZone) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorIntentsForZone) MarshalTo(dAtA []byte) (int, error) {
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorIntentsForZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Snapshot {
+	if m.RedelegationEnd != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RedelegationEnd))
 		i--
-		if m.Snapshot {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	if len(m.DelegationIntent) > 0 {
-		for iNdEx := len(m.DelegationIntent) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DelegationIntent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegationAddress) > 0 {
+		i -= len(m.DelegationAddress)
+		copy(dAtA[i:], m.DelegationAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegationAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *PortConnectionTuple) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2335,34 +3350,57 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *PortConnectionTuple) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PortConnectionTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PortConnections) > 0 {
-		for iNdEx := len(m.PortConnections) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PortConnections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.DelegatorIntents) > 0 {
-		for iNdEx := len(m.DelegatorIntents) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Receipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegationPlans) > 0 {
+		for iNdEx := len(m.DelegationPlans) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegatorIntents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.DelegationPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2373,10 +3411,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x32
 		}
 	}
-	if len(m.DelegationPlans) > 0 {
-		for iNdEx := len(m.DelegationPlans) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.QassetAmount) > 0 {
+		for iNdEx := len(m.QassetAmount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegationPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.QassetAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2387,10 +3425,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2401,24 +3439,78 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x22
 		}
 	}
-	if len(m.Receipts) > 0 {
-		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.Txhash) > 0 {
+		i -= len(m.Txhash)
+		copy(dAtA[i:], m.Txhash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Txhash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Zone != nil {
+		{
+			size, err := m.Zone.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Zones) > 0 {
-		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *Refund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Refund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Refund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Batch) > 0 {
+		i -= len(m.Batch)
+		copy(dAtA[i:], m.Batch)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Batch)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2426,504 +3518,3757 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txhash) > 0 {
+		i -= len(m.Txhash)
+		copy(dAtA[i:], m.Txhash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Txhash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *IBCDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RegisteredZone) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *IBCDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Acknowledged {
+		i--
+		if m.Acknowledged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.DepositAddress != nil {
-		l = m.DepositAddress.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.WithdrawalAddress != nil {
-		l = m.WithdrawalAddress.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.PerformanceAddress != nil {
-		l = m.PerformanceAddress.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Intent) > 0 {
+		i -= len(m.Intent)
+		copy(dAtA[i:], m.Intent)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Intent)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.DelegationAddresses) > 0 {
-		for _, e := range m.DelegationAddresses {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.AccountPrefix)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.LocalDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashingIncident) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.RedemptionRate.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.LastRedemptionRate.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *SlashingIncident) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingIncident) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokensLost.Size()
+		i -= size
+		if _, err := m.TokensLost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.AggregateIntent) > 0 {
-		for k, v := range m.AggregateIntent {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovGenesis(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.MultiSend {
-		n += 2
-	}
-	if m.LiquidityModule {
-		n += 2
-	}
-	if m.WithdrawalWaitgroup != 0 {
-		n += 2 + sovGenesis(uint64(m.WithdrawalWaitgroup))
+	i--
+	dAtA[i] = 0x2a
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err15 != nil {
+		return 0, err15
 	}
-	l = len(m.IbcNextValidatorsHash)
-	if l > 0 {
-		n += 2 + l + sovGenesis(uint64(l))
+	i -= n15
+	i = encodeVarintGenesis(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.ValidatorSelectionAllocation) > 0 {
-		for _, e := range m.ValidatorSelectionAllocation {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.HoldingsAllocation) > 0 {
-		for _, e := range m.HoldingsAllocation {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ICAAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IcaOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IcaOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IcaOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
 	}
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = m.DelegatedBalance.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.PortName)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.BalanceWaitgroup != 0 {
-		n += 1 + sovGenesis(uint64(m.BalanceWaitgroup))
+	if m.Attempts != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *WithdrawalRecord) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.BurnAmount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.Txhash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Status != 0 {
-		n += 1 + sovGenesis(uint64(m.Status))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TransferRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Value) > 0 {
+		for iNdEx := len(m.Value) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Value[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValoperAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = m.CommissionRate.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.DelegatorShares.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.VotingPower.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.Score.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DelegatorIntent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxMsgsPerTx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMsgsPerTx))
+		i--
+		dAtA[i] = 0x58
 	}
-	if len(m.Intents) > 0 {
-		for _, e := range m.Intents {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.TreasuryFeeShare.Size()
+		i -= size
+		if _, err := m.TreasuryFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ValidatorIntent) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.CommunityPoolFeeShare.Size()
+		i -= size
+		if _, err := m.CommunityPoolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.ValoperAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = m.Weight.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x3a
+	if m.ValidatorsetInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValidatorsetInterval))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = len(m.DelegationAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.DelegationsInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegationsInterval))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.DelegateInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegateInterval))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovGenesis(uint64(m.Height))
+	if m.DepositInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DepositInterval))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.RedelegationEnd != 0 {
-		n += 1 + sovGenesis(uint64(m.RedelegationEnd))
+	if m.DelegationAccountSplit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegationAccountSplit))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.DelegationAccountCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegationAccountCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PortConnectionTuple) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationsForZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationsForZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationsForZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Receipt) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationPlansForZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationPlansForZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationPlansForZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Zone != nil {
-		l = m.Zone.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.Txhash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DelegationPlans) > 0 {
+		for k := range m.DelegationPlans {
+			v := m.DelegationPlans[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintGenesis(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationPlan) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegatorIntentsForZone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorIntentsForZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorIntentsForZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Value) > 0 {
-		for _, e := range m.Value {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DelegationIntent) > 0 {
+		for iNdEx := len(m.DelegationIntent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationIntent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DelegationAccountCount != 0 {
-		n += 1 + sovGenesis(uint64(m.DelegationAccountCount))
-	}
-	if m.DelegationAccountSplit != 0 {
-		n += 1 + sovGenesis(uint64(m.DelegationAccountSplit))
-	}
-	if m.DepositInterval != 0 {
-		n += 1 + sovGenesis(uint64(m.DepositInterval))
-	}
-	if m.DelegateInterval != 0 {
-		n += 1 + sovGenesis(uint64(m.DelegateInterval))
-	}
-	if m.DelegationsInterval != 0 {
-		n += 1 + sovGenesis(uint64(m.DelegationsInterval))
-	}
-	if m.ValidatorsetInterval != 0 {
-		n += 1 + sovGenesis(uint64(m.ValidatorsetInterval))
+func (m *ZoneFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.CommissionRate.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DelegationsForZone) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	return n
+func (m *ZoneFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationPlansForZone) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ZoneFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.DelegationPlans) > 0 {
-		for k, v := range m.DelegationPlans {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovGenesis(uint64(l))
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorIntentsForZone) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValsetPass) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValsetPass) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValsetPass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.DelegationIntent) > 0 {
-		for _, e := range m.DelegationIntent {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.Snapshot {
-		n += 2
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Zones) > 0 {
-		for _, e := range m.Zones {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValsetPasses) > 0 {
+		for iNdEx := len(m.ValsetPasses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetPasses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
 		}
 	}
-	if len(m.Receipts) > 0 {
-		for _, e := range m.Receipts {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RedemptionEscrows) > 0 {
+		for iNdEx := len(m.RedemptionEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
 		}
 	}
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ZoneFees) > 0 {
+		for iNdEx := len(m.ZoneFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ZoneFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
 		}
 	}
-	if len(m.DelegationPlans) > 0 {
-		for _, e := range m.DelegationPlans {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.IcaOperations) > 0 {
+		for iNdEx := len(m.IcaOperations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
 		}
 	}
-	if len(m.DelegatorIntents) > 0 {
-		for _, e := range m.DelegatorIntents {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SlashingIncidents) > 0 {
+		for iNdEx := len(m.SlashingIncidents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingIncidents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
 		}
 	}
-	if len(m.PortConnections) > 0 {
-		for _, e := range m.PortConnections {
-			l = e.Size()
+	if len(m.IbcDeposits) > 0 {
+		for iNdEx := len(m.IbcDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Refunds) > 0 {
+		for iNdEx := len(m.Refunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.WithdrawalRecords) > 0 {
+		for iNdEx := len(m.WithdrawalRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawalRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PortConnections) > 0 {
+		for iNdEx := len(m.PortConnections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PortConnections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DelegatorIntents) > 0 {
+		for iNdEx := len(m.DelegatorIntents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorIntents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DelegationPlans) > 0 {
+		for iNdEx := len(m.DelegationPlans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationPlans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegisteredZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DepositAddress != nil {
+		l = m.DepositAddress.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.WithdrawalAddress != nil {
+		l = m.WithdrawalAddress.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PerformanceAddress != nil {
+		l = m.PerformanceAddress.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DelegationAddresses) > 0 {
+		for _, e := range m.DelegationAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.AccountPrefix)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.LocalDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LastRedemptionRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.AggregateIntent) > 0 {
+		for k, v := range m.AggregateIntent {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovGenesis(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if m.MultiSend {
+		n += 2
+	}
+	if m.LiquidityModule {
+		n += 2
+	}
+	if m.WithdrawalWaitgroup != 0 {
+		n += 2 + sovGenesis(uint64(m.WithdrawalWaitgroup))
+	}
+	l = len(m.IbcNextValidatorsHash)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ValidatorSelectionAllocation) > 0 {
+		for _, e := range m.ValidatorSelectionAllocation {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HoldingsAllocation) > 0 {
+		for _, e := range m.HoldingsAllocation {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DeferredEpoch != 0 {
+		n += 2 + sovGenesis(uint64(m.DeferredEpoch))
+	}
+	if m.Sunsetting {
+		n += 3
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SunsetCompletion)
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.CommissionRate.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.MaxRedemptionRateChange.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.MinRedemptionRate.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.MaxRedemptionRate.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.CircuitBreakerTripped {
+		n += 3
+	}
+	if m.State != 0 {
+		n += 2 + sovGenesis(uint64(m.State))
+	}
+	if len(m.ValidatorDenylist) > 0 {
+		for _, s := range m.ValidatorDenylist {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorAllowlist) > 0 {
+		for _, s := range m.ValidatorAllowlist {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DelegationAccountCount != 0 {
+		n += 2 + sovGenesis(uint64(m.DelegationAccountCount))
+	}
+	l = m.RewardsDust.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ICAAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.DelegatedBalance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.PortName)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BalanceWaitgroup != 0 {
+		n += 1 + sovGenesis(uint64(m.BalanceWaitgroup))
+	}
+	if m.Unavailable {
+		n += 2
+	}
+	if m.Retired {
+		n += 2
+	}
+	return n
+}
+
+func (m *WithdrawalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BurnAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Txhash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGenesis(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.UnbondingBatch)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RedemptionEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Txhash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Redeemer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Refunded.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.VotingPower.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Intents) > 0 {
+		for _, e := range m.Intents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegationAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.RedelegationEnd != 0 {
+		n += 1 + sovGenesis(uint64(m.RedelegationEnd))
+	}
+	return n
+}
+
+func (m *PortConnectionTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Receipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Zone != nil {
+		l = m.Zone.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Txhash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QassetAmount) > 0 {
+		for _, e := range m.QassetAmount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegationPlans) > 0 {
+		for _, e := range m.DelegationPlans {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Refund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Txhash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGenesis(uint64(m.Status))
+	}
+	l = len(m.Batch)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *IBCDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Intent)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	if m.Acknowledged {
+		n += 2
+	}
+	return n
+}
+
+func (m *SlashingIncident) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TokensLost.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *IcaOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovGenesis(uint64(m.Attempts))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGenesis(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *DelegationPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Value) > 0 {
+		for _, e := range m.Value {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationAccountCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DelegationAccountCount))
+	}
+	if m.DelegationAccountSplit != 0 {
+		n += 1 + sovGenesis(uint64(m.DelegationAccountSplit))
+	}
+	if m.DepositInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.DepositInterval))
+	}
+	if m.DelegateInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.DelegateInterval))
+	}
+	if m.DelegationsInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.DelegationsInterval))
+	}
+	if m.ValidatorsetInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.ValidatorsetInterval))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CommunityPoolFeeShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TreasuryFeeShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxMsgsPerTx != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxMsgsPerTx))
+	}
+	return n
+}
+
+func (m *DelegationsForZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationPlansForZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DelegationPlans) > 0 {
+		for k, v := range m.DelegationPlans {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovGenesis(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorIntentsForZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DelegationIntent) > 0 {
+		for _, e := range m.DelegationIntent {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Snapshot {
+		n += 2
+	}
+	return n
+}
+
+func (m *ZoneFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValsetPass) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegationPlans) > 0 {
+		for _, e := range m.DelegationPlans {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorIntents) > 0 {
+		for _, e := range m.DelegatorIntents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PortConnections) > 0 {
+		for _, e := range m.PortConnections {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawalRecords) > 0 {
+		for _, e := range m.WithdrawalRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Refunds) > 0 {
+		for _, e := range m.Refunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcDeposits) > 0 {
+		for _, e := range m.IbcDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashingIncidents) > 0 {
+		for _, e := range m.SlashingIncidents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaOperations) > 0 {
+		for _, e := range m.IcaOperations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ZoneFees) > 0 {
+		for _, e := range m.ZoneFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedemptionEscrows) > 0 {
+		for _, e := range m.RedemptionEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetPasses) > 0 {
+		for _, e := range m.ValsetPasses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositAddress == nil {
+				m.DepositAddress = &ICAAccount{}
+			}
+			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithdrawalAddress == nil {
+				m.WithdrawalAddress = &ICAAccount{}
+			}
+			if err := m.WithdrawalAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PerformanceAddress == nil {
+				m.PerformanceAddress = &ICAAccount{}
+			}
+			if err := m.PerformanceAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationAddresses = append(m.DelegationAddresses, &ICAAccount{})
+			if err := m.DelegationAddresses[len(m.DelegationAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateIntent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AggregateIntent == nil {
+				m.AggregateIntent = make(map[string]*ValidatorIntent)
+			}
+			var mapkey string
+			var mapvalue *ValidatorIntent
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ValidatorIntent{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AggregateIntent[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MultiSend = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidityModule = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalWaitgroup", wireType)
+			}
+			m.WithdrawalWaitgroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalWaitgroup |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcNextValidatorsHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcNextValidatorsHash = append(m.IbcNextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.IbcNextValidatorsHash == nil {
+				m.IbcNextValidatorsHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSelectionAllocation = append(m.ValidatorSelectionAllocation, types.Coin{})
+			if err := m.ValidatorSelectionAllocation[len(m.ValidatorSelectionAllocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HoldingsAllocation = append(m.HoldingsAllocation, types.Coin{})
+			if err := m.HoldingsAllocation[len(m.HoldingsAllocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredEpoch", wireType)
+			}
+			m.DeferredEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeferredEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sunsetting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sunsetting = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetCompletion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SunsetCompletion, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRedemptionRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerTripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CircuitBreakerTripped = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= ZoneState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDenylist = append(m.ValidatorDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAllowlist = append(m.ValidatorAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccountCount", wireType)
+			}
+			m.DelegationAccountCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationAccountCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsDust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardsDust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWaitgroup", wireType)
+			}
+			m.BalanceWaitgroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceWaitgroup |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unavailable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unavailable = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= WithdrawalRecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingBatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingBatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redeemer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
+func (m *Validator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2933,28 +7278,162 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredZone: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredZone: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2982,11 +7461,13 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3014,13 +7495,53 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3030,31 +7551,109 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DepositAddress == nil {
-				m.DepositAddress = &ICAAccount{}
-			}
-			if err := m.DepositAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3081,18 +7680,66 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithdrawalAddress == nil {
-				m.WithdrawalAddress = &ICAAccount{}
-			}
-			if err := m.WithdrawalAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Intents = append(m.Intents, &ValidatorIntent{})
+			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3102,33 +7749,29 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PerformanceAddress == nil {
-				m.PerformanceAddress = &ICAAccount{}
-			}
-			if err := m.PerformanceAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3138,29 +7781,79 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegationAddresses = append(m.DelegationAddresses, &ICAAccount{})
-			if err := m.DelegationAddresses[len(m.DelegationAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3188,11 +7881,11 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			m.DelegationAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3220,13 +7913,13 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3236,27 +7929,116 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEnd", wireType)
+			}
+			m.RedelegationEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedelegationEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortConnectionTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortConnectionTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3284,13 +8066,11 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3318,13 +8098,61 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 12:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Receipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3351,16 +8179,18 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Zone == nil {
+				m.Zone = &RegisteredZone{}
+			}
+			if err := m.Zone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateIntent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3370,126 +8200,29 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AggregateIntent == nil {
-				m.AggregateIntent = make(map[string]*ValidatorIntent)
-			}
-			var mapkey string
-			var mapvalue *ValidatorIntent
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ValidatorIntent{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenesis(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.AggregateIntent[mapkey] = mapvalue
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3499,56 +8232,29 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MultiSend = bool(v != 0)
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.LiquidityModule = bool(v != 0)
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalWaitgroup", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.WithdrawalWaitgroup = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WithdrawalWaitgroup |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 17:
+			m.Txhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcNextValidatorsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3558,29 +8264,29 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcNextValidatorsHash = append(m.IbcNextValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.IbcNextValidatorsHash == nil {
-				m.IbcNextValidatorsHash = []byte{}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QassetAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3607,14 +8313,14 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSelectionAllocation = append(m.ValidatorSelectionAllocation, types.Coin{})
-			if err := m.ValidatorSelectionAllocation[len(m.ValidatorSelectionAllocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QassetAmount = append(m.QassetAmount, types.Coin{})
+			if err := m.QassetAmount[len(m.QassetAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPlans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3641,8 +8347,8 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HoldingsAllocation = append(m.HoldingsAllocation, types.Coin{})
-			if err := m.HoldingsAllocation[len(m.HoldingsAllocation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegationPlans = append(m.DelegationPlans, DelegationPlan{})
+			if err := m.DelegationPlans[len(m.DelegationPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3667,7 +8373,7 @@ func (m *RegisteredZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+func (m *Refund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3677,28 +8383,60 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Refund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Refund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3726,13 +8464,13 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Txhash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3742,29 +8480,27 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3791,13 +8527,14 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatedBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3825,13 +8562,13 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortName = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWaitgroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.BalanceWaitgroup = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3841,11 +8578,43 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BalanceWaitgroup |= uint32(b&0x7F) << shift
+				m.Status |= RefundStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3867,7 +8636,7 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
+func (m *IBCDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3890,15 +8659,15 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawalRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: IBCDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IBCDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3926,11 +8695,11 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3958,13 +8727,13 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -3974,29 +8743,31 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4006,30 +8777,29 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Intent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4039,30 +8809,29 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4072,29 +8841,16 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txhash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledged", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4104,11 +8860,12 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Acknowledged = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4130,7 +8887,7 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+func (m *SlashingIncident) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4153,15 +8910,47 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashingIncident: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashingIncident: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4182,18 +8971,70 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4221,13 +9062,15 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensLost", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4237,22 +9080,23 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokensLost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4277,7 +9121,7 @@ func (m *TransferRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *IcaOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4300,15 +9144,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: IcaOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IcaOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4336,11 +9180,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4368,13 +9212,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4402,13 +9244,30 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4436,15 +9295,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4454,79 +9311,48 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorIntent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4554,13 +9380,13 @@ func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4570,26 +9396,30 @@ func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Intents = append(m.Intents, &ValidatorIntent{})
-			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= IcaOperationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4611,7 +9441,7 @@ func (m *DelegatorIntent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
+func (m *DelegationPlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4634,15 +9464,15 @@ func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIntent: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationPlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationPlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4670,11 +9500,11 @@ func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4702,7 +9532,39 @@ func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value, types.Coin{})
+			if err := m.Value[len(m.Value)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4727,7 +9589,7 @@ func (m *ValidatorIntent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4750,17 +9612,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccountCount", wireType)
 			}
-			var stringLen uint64
+			m.DelegationAccountCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4770,29 +9632,16 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DelegationAccountCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegationAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccountSplit", wireType)
 			}
-			var stringLen uint64
+			m.DelegationAccountSplit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4802,29 +9651,16 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DelegationAccountSplit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositInterval", wireType)
 			}
-			var msglen int
+			m.DepositInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4834,30 +9670,16 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DepositInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateInterval", wireType)
 			}
-			m.Height = 0
+			m.DelegateInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4867,16 +9689,16 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.DelegateInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEnd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationsInterval", wireType)
 			}
-			m.RedelegationEnd = 0
+			m.DelegationsInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -4886,64 +9708,33 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RedelegationEnd |= int64(b&0x7F) << shift
+				m.DelegationsInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsetInterval", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ValidatorsetInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsetInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PortConnectionTuple: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PortConnectionTuple: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4971,11 +9762,13 @@ func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolFeeShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5003,63 +9796,15 @@ func (m *PortConnectionTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CommunityPoolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Receipt) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFeeShare", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5069,31 +9814,29 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Zone == nil {
-				m.Zone = &RegisteredZone{}
-			}
-			if err := m.Zone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TreasuryFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5121,11 +9864,80 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerTx", wireType)
+			}
+			m.MaxMsgsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationsForZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationsForZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5153,11 +9965,11 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txhash = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5184,8 +9996,8 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, &Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5210,7 +10022,7 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationPlan) Unmarshal(dAtA []byte) error {
+func (m *DelegationPlansForZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5233,15 +10045,15 @@ func (m *DelegationPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationPlansForZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationPlansForZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5269,43 +10081,11 @@ func (m *DelegationPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPlans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5332,10 +10112,105 @@ func (m *DelegationPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value, types.Coin{})
-			if err := m.Value[len(m.Value)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.DelegationPlans == nil {
+				m.DelegationPlans = make(map[string]*DelegationPlan)
+			}
+			var mapkey string
+			var mapvalue *DelegationPlan
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DelegationPlan{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.DelegationPlans[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5358,7 +10233,7 @@ func (m *DelegationPlan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DelegatorIntentsForZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5381,17 +10256,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorIntentsForZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorIntentsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccountCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.DelegationAccountCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5401,16 +10276,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationAccountCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccountSplit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationIntent", wireType)
 			}
-			m.DelegationAccountSplit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5420,54 +10308,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationAccountSplit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositInterval", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.DepositInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DepositInterval |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateInterval", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DelegateInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DelegateInterval |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.DelegationIntent = append(m.DelegationIntent, &DelegatorIntent{})
+			if err := m.DelegationIntent[len(m.DelegationIntent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 5:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationsInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			m.DelegationsInterval = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5477,16 +10342,67 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationsInterval |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsetInterval", wireType)
+			m.Snapshot = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ValidatorsetInterval = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZoneFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZoneFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZoneFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5496,16 +10412,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorsetInterval |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5515,23 +10444,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5556,7 +10485,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationsForZone) Unmarshal(dAtA []byte) error {
+func (m *ValsetPass) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5579,10 +10508,10 @@ func (m *DelegationsForZone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationsForZone: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValsetPass: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValsetPass: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5619,9 +10548,9 @@ func (m *DelegationsForZone) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5631,25 +10560,55 @@ func (m *DelegationsForZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, &Delegation{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5672,7 +10631,7 @@ func (m *DelegationsForZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationPlansForZone) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5695,17 +10654,17 @@ func (m *DelegationPlansForZone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationPlansForZone: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationPlansForZone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5715,27 +10674,28 @@ func (m *DelegationPlansForZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPlans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5759,164 +10719,87 @@ func (m *DelegationPlansForZone) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DelegationPlans == nil {
-				m.DelegationPlans = make(map[string]*DelegationPlan)
-			}
-			var mapkey string
-			var mapvalue *DelegationPlan
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &DelegationPlan{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenesis(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DelegationPlans[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
+			m.Zones = append(m.Zones, RegisteredZone{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegatorIntentsForZone) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.Receipts = append(m.Receipts, Receipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Delegations = append(m.Delegations, DelegationsForZone{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorIntentsForZone: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorIntentsForZone: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPlans", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5926,27 +10809,29 @@ func (m *DelegatorIntentsForZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.DelegationPlans = append(m.DelegationPlans, DelegationPlansForZone{})
+			if err := m.DelegationPlans[len(m.DelegationPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationIntent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorIntents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5973,16 +10858,16 @@ func (m *DelegatorIntentsForZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegationIntent = append(m.DelegationIntent, &DelegatorIntent{})
-			if err := m.DelegationIntent[len(m.DelegationIntent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatorIntents = append(m.DelegatorIntents, DelegatorIntentsForZone{})
+			if err := m.DelegatorIntents[len(m.DelegatorIntents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortConnections", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5992,65 +10877,63 @@ func (m *DelegatorIntentsForZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Snapshot = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.PortConnections = append(m.PortConnections, PortConnectionTuple{})
+			if err := m.PortConnections[len(m.PortConnections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WithdrawalRecords = append(m.WithdrawalRecords, WithdrawalRecord{})
+			if err := m.WithdrawalRecords[len(m.WithdrawalRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6077,13 +10960,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Refunds = append(m.Refunds, Refund{})
+			if err := m.Refunds[len(m.Refunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDeposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6110,14 +10994,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zones = append(m.Zones, RegisteredZone{})
-			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IbcDeposits = append(m.IbcDeposits, IBCDeposit{})
+			if err := m.IbcDeposits[len(m.IbcDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingIncidents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6144,14 +11028,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receipts = append(m.Receipts, Receipt{})
-			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashingIncidents = append(m.SlashingIncidents, SlashingIncident{})
+			if err := m.SlashingIncidents[len(m.SlashingIncidents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaOperations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6178,14 +11062,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, DelegationsForZone{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IcaOperations = append(m.IcaOperations, IcaOperation{})
+			if err := m.IcaOperations[len(m.IcaOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPlans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6212,14 +11096,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegationPlans = append(m.DelegationPlans, DelegationPlansForZone{})
-			if err := m.DelegationPlans[len(m.DelegationPlans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ZoneFees = append(m.ZoneFees, ZoneFees{})
+			if err := m.ZoneFees[len(m.ZoneFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorIntents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionEscrows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6246,14 +11130,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorIntents = append(m.DelegatorIntents, DelegatorIntentsForZone{})
-			if err := m.DelegatorIntents[len(m.DelegatorIntents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedemptionEscrows = append(m.RedemptionEscrows, RedemptionEscrow{})
+			if err := m.RedemptionEscrows[len(m.RedemptionEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortConnections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetPasses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6280,8 +11164,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortConnections = append(m.PortConnections, PortConnectionTuple{})
-			if err := m.PortConnections[len(m.PortConnections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValsetPasses = append(m.ValsetPasses, ValsetPass{})
+			if err := m.ValsetPasses[len(m.ValsetPasses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,13 @@
+package types
+
+// IBCDepositMemo is the memo of an IBC transfer to be liquid staked on receipt, of the form:
+//
+//	{"liquid_stake": {"intent": "<intent memo>"}}
+type IBCDepositMemo struct {
+	LiquidStake *LiquidStakeMemo `json:"liquid_stake"`
+}
+
+// LiquidStakeMemo holds the optional validator intent of the deposit, encoded as a deposit memo (see ParseMemo).
+type LiquidStakeMemo struct {
+	Intent string `json:"intent,omitempty"`
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SplitIcaOperations splits msgs into the messages of consecutive ICA operations of at most size messages each,
+// preserving order.
+func SplitIcaOperations(msgs []sdk.Msg, size int) [][]sdk.Msg {
+	if size <= 0 {
+		size = len(msgs)
+	}
+
+	operations := [][]sdk.Msg{}
+	for len(msgs) > size {
+		operations = append(operations, msgs[:size:size])
+		msgs = msgs[size:]
+	}
+	if len(msgs) > 0 {
+		operations = append(operations, msgs)
+	}
+	return operations
+}
@@ -0,0 +1,30 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestSplitIcaOperations(t *testing.T) {
+	msgs := make([]sdk.Msg, 7)
+	for i := range msgs {
+		msgs[i] = &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(int64(i+1))))}
+	}
+
+	operations := types.SplitIcaOperations(msgs, 3)
+	require.Len(t, operations, 3)
+	require.Len(t, operations[0], 3)
+	require.Len(t, operations[1], 3)
+	require.Len(t, operations[2], 1)
+	require.Equal(t, msgs[3], operations[1][0])
+	require.Equal(t, msgs[6], operations[2][0])
+
+	require.Len(t, types.SplitIcaOperations(msgs, 7), 1)
+	require.Len(t, types.SplitIcaOperations(msgs, 10), 1)
+	require.Len(t, types.SplitIcaOperations(nil, 3), 0)
+}
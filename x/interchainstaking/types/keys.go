@@ -26,6 +26,24 @@ const (
 
 	TxRetrieveCount = 100
 
+	// MaxRedelegationsPerEpoch bounds the number of MsgBeginRedelegate issued per zone when rebalancing.
+	MaxRedelegationsPerEpoch = 20
+	// RebalanceThresholdBasisPoints is the minimum deviation from intent, relative to the total delegated amount, to trigger a redelegation.
+	RebalanceThresholdBasisPoints = 50
+	// MaxRefundsPerBatch bounds the number of refunds submitted per zone in a single deposit interval.
+	MaxRefundsPerBatch = 20
+	// MaxDrainsPerEpoch bounds the number of delegations moved from retired delegation accounts per zone per epoch.
+	MaxDrainsPerEpoch = 20
+	// RedemptionRateInvariantToleranceBasisPoints is the deviation of the value of a zone's qAsset supply from its
+	// delegated amount plus pending deposits and withdrawals, relative to the value of the supply, above which the
+	// redemption rate invariant logs the zone.
+	RedemptionRateInvariantToleranceBasisPoints = 500
+	// MaxIcaOperationAttempts bounds the number of times an interchain account operation is submitted.
+	MaxIcaOperationAttempts = 3
+	// IcaOperationRetentionBlocks is the number of blocks failed and timed out interchain account operations are
+	// retained for, after their last submission.
+	IcaOperationRetentionBlocks = 100800
+
 	QueryParameters                   = "params"
 	QueryRegisteredZonesInfo          = "zones"
 	QueryRegisteredZoneDepositAddress = "zones/deposit_address"
@@ -40,6 +58,13 @@ var (
 	KeyPrefixDelegation       = []byte{0x06}
 	KeyPrefixDelegationPlan   = []byte{0x07}
 	KeyPrefixSnapshotIntent   = []byte{0x08}
+	KeyPrefixFees             = []byte{0x09}
+	KeyPrefixRefund           = []byte{0x0a}
+	KeyPrefixIBCDeposit       = []byte{0x0b}
+	KeyPrefixSlashingIncident = []byte{0x0c}
+	KeyPrefixValsetPass       = []byte{0x0d}
+	KeyPrefixIcaOperation     = []byte{0x0e}
+	KeyPrefixRedemptionEscrow = []byte{0x0f}
 )
 
 func KeyPrefix(p string) []byte {
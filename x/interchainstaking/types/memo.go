@@ -0,0 +1,224 @@
+package types
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// Deposit memos are base64 encoded binary. Legacy memos are a repeated sequence of one weight byte (1-200) and a 20
+// byte validator address. Versioned memos are a version byte, followed by a sequence of fields, each a field id byte,
+// a length byte and the value:
+//
+//	intent (0x00):    repeated weight byte (1-200, summing to 200) and 20 byte validator address.
+//	recipient (0x01): address bytes of the qAsset recipient on Quicksilver.
+//	referral (0x02):  referral tag, of up to MaxMemoReferralLength bytes.
+//
+// Version bytes start at 0xf1, so never collide with legacy memo weights.
+const (
+	MemoVersion1 byte = 0xf1
+
+	MemoFieldIntent    byte = 0x00
+	MemoFieldRecipient byte = 0x01
+	MemoFieldReferral  byte = 0x02
+
+	// MemoWeightDenominator is the denominator of memo intent weights; a weight of 200 is 100%.
+	MemoWeightDenominator = 200
+	// MaxMemoReferralLength is the maximum length of memo referral tags.
+	MaxMemoReferralLength = 32
+
+	memoIntentLength = 21
+)
+
+// DepositMemo is a decoded deposit memo.
+type DepositMemo struct {
+	// Intents are the validator intents of the deposit, with weights summing to one.
+	Intents ValidatorIntents
+	// Recipient is the optional recipient of the minted qAssets.
+	Recipient sdk.AccAddress
+	// Referral is the optional referral tag of the deposit.
+	Referral string
+}
+
+// ParseMemo decodes and validates a deposit memo against the zone. An empty memo decodes to an empty DepositMemo.
+func (z *RegisteredZone) ParseMemo(memo string) (DepositMemo, error) {
+	out := DepositMemo{Intents: ValidatorIntents{}}
+	if len(memo) == 0 {
+		return out, nil
+	}
+
+	memoBytes, err := base64.StdEncoding.DecodeString(memo)
+	if err != nil {
+		return out, fmt.Errorf("unable to decode base64 memo: %w", err)
+	}
+
+	if len(memoBytes) == 0 {
+		return out, nil
+	}
+
+	if memoBytes[0] < MemoVersion1 {
+		// legacy memo; intents only.
+		out.Intents, err = z.parseMemoIntents(memoBytes)
+		return out, err
+	}
+
+	if memoBytes[0] != MemoVersion1 {
+		return out, fmt.Errorf("unsupported memo version %d", memoBytes[0])
+	}
+
+	seen := map[byte]bool{}
+	for index := 1; index < len(memoBytes); {
+		if index+2 > len(memoBytes) {
+			return out, fmt.Errorf("truncated memo field at index %d", index)
+		}
+		field, length := memoBytes[index], int(memoBytes[index+1])
+		index += 2
+		if index+length > len(memoBytes) {
+			return out, fmt.Errorf("truncated memo field %d", field)
+		}
+		value := memoBytes[index : index+length]
+		index += length
+
+		if seen[field] {
+			return out, fmt.Errorf("duplicate memo field %d", field)
+		}
+		seen[field] = true
+
+		switch field {
+		case MemoFieldIntent:
+			if out.Intents, err = z.parseMemoIntents(value); err != nil {
+				return out, err
+			}
+			if err := validateMemoWeights(value); err != nil {
+				return out, err
+			}
+		case MemoFieldRecipient:
+			if err := sdk.VerifyAddressFormat(value); err != nil {
+				return out, fmt.Errorf("invalid memo recipient: %w", err)
+			}
+			out.Recipient = value
+		case MemoFieldReferral:
+			if length == 0 || length > MaxMemoReferralLength {
+				return out, fmt.Errorf("memo referral must be between 1 and %d bytes", MaxMemoReferralLength)
+			}
+			out.Referral = string(value)
+		default:
+			return out, fmt.Errorf("unknown memo field %d", field)
+		}
+	}
+
+	return out, nil
+}
+
+// parseMemoIntents decodes a repeated sequence of weight byte and validator address into intents with fractional
+// weights.
+func (z *RegisteredZone) parseMemoIntents(bz []byte) (ValidatorIntents, error) {
+	out := ValidatorIntents{}
+	if len(bz)%memoIntentLength != 0 {
+		return out, fmt.Errorf("memo intents must be a multiple of %d bytes, got %d", memoIntentLength, len(bz))
+	}
+
+	for index := 0; index < len(bz); index += memoIntentLength {
+		weight := bz[index]
+		if weight == 0 || weight > MemoWeightDenominator {
+			return out, fmt.Errorf("memo intent weight must be between 1 and %d, got %d", MemoWeightDenominator, weight)
+		}
+		valAddr, err := bech32.ConvertAndEncode(z.AccountPrefix+"valoper", bz[index+1:index+memoIntentLength])
+		if err != nil {
+			return out, err
+		}
+		if _, err := z.GetValidatorByValoper(valAddr); err != nil {
+			return out, fmt.Errorf("unknown validator %s", valAddr)
+		}
+		if z.IsValidatorExcluded(valAddr) {
+			return out, fmt.Errorf("validator %s is not eligible for delegation", valAddr)
+		}
+
+		val, ok := out[valAddr]
+		if !ok {
+			val = &ValidatorIntent{ValoperAddress: valAddr, Weight: sdk.ZeroDec()}
+		}
+		val.Weight = val.Weight.Add(sdk.NewDec(int64(weight)).QuoInt64(MemoWeightDenominator))
+		out[valAddr] = val
+	}
+	return out, nil
+}
+
+func validateMemoWeights(bz []byte) error {
+	total := 0
+	for index := 0; index < len(bz); index += memoIntentLength {
+		total += int(bz[index])
+	}
+	if total != MemoWeightDenominator {
+		return fmt.Errorf("memo intent weights must sum to %d, got %d", MemoWeightDenominator, total)
+	}
+	return nil
+}
+
+// ToOrdinalIntents scales the memo intents by the base denom amount of the deposit.
+func (m DepositMemo) ToOrdinalIntents(coins sdk.Coins, baseDenom string) ValidatorIntents {
+	out := ValidatorIntents{}
+	for _, key := range m.Intents.Keys() {
+		intent := m.Intents[key]
+		out[key] = &ValidatorIntent{ValoperAddress: intent.ValoperAddress, Weight: intent.Weight.MulInt(coins.AmountOf(baseDenom))}
+	}
+	return out
+}
+
+// EncodeMemo encodes a versioned deposit memo. Intent weights are fractions summing to one, in increments of 0.005.
+func EncodeMemo(intents []*ValidatorIntent, recipient sdk.AccAddress, referral string) (string, error) {
+	out := []byte{MemoVersion1}
+
+	if len(intents) > 0 {
+		value := []byte{}
+		total := 0
+		for _, intent := range intents {
+			scaled := intent.Weight.MulInt64(MemoWeightDenominator)
+			if !scaled.IsInteger() || !scaled.IsPositive() {
+				return "", fmt.Errorf("intent weight %s for %s must be a positive multiple of 0.005", intent.Weight, intent.ValoperAddress)
+			}
+			weight := scaled.TruncateInt64()
+			total += int(weight)
+			if total > MemoWeightDenominator {
+				return "", fmt.Errorf("intent weights must sum to one")
+			}
+			_, addr, err := bech32.DecodeAndConvert(intent.ValoperAddress)
+			if err != nil {
+				return "", fmt.Errorf("invalid validator address %s: %w", intent.ValoperAddress, err)
+			}
+			if len(addr) != memoIntentLength-1 {
+				return "", fmt.Errorf("validator address %s must be %d bytes", intent.ValoperAddress, memoIntentLength-1)
+			}
+			value = append(value, byte(weight))
+			value = append(value, addr...)
+		}
+		if total != MemoWeightDenominator {
+			return "", fmt.Errorf("intent weights must sum to one")
+		}
+		if len(value) > 255 {
+			return "", fmt.Errorf("too many intents; at most %d validators are supported", 255/memoIntentLength)
+		}
+		out = append(out, MemoFieldIntent, byte(len(value)))
+		out = append(out, value...)
+	}
+
+	if len(recipient) > 0 {
+		if err := sdk.VerifyAddressFormat(recipient); err != nil {
+			return "", fmt.Errorf("invalid recipient: %w", err)
+		}
+		out = append(out, MemoFieldRecipient, byte(len(recipient)))
+		out = append(out, recipient...)
+	}
+
+	if referral != "" {
+		if len(referral) > MaxMemoReferralLength {
+			return "", fmt.Errorf("referral must be at most %d bytes", MaxMemoReferralLength)
+		}
+		out = append(out, MemoFieldReferral, byte(len(referral)))
+		out = append(out, []byte(referral)...)
+	}
+
+	return base64.StdEncoding.EncodeToString(out), nil
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func memoTestZone() types.RegisteredZone {
+	zone := types.RegisteredZone{ConnectionId: "connection-0", ChainId: "cosmoshub-4", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	zone.Validators = append(zone.Validators, &types.Validator{ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", CommissionRate: sdk.MustNewDecFromStr("0.2"), VotingPower: sdk.NewInt(2000)})
+	zone.Validators = append(zone.Validators, &types.Validator{ValoperAddress: "cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf", CommissionRate: sdk.MustNewDecFromStr("0.2"), VotingPower: sdk.NewInt(2000)})
+	return zone
+}
+
+func TestEncodeParseMemo(t *testing.T) {
+	zone := memoTestZone()
+	recipient := sdk.AccAddress(make([]byte, 20))
+
+	intents := []*types.ValidatorIntent{
+		{ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", Weight: sdk.MustNewDecFromStr("0.3")},
+		{ValoperAddress: "cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf", Weight: sdk.MustNewDecFromStr("0.7")},
+	}
+	memo, err := types.EncodeMemo(intents, recipient, "partner")
+	require.NoError(t, err)
+
+	out, err := zone.ParseMemo(memo)
+	require.NoError(t, err)
+	require.Equal(t, recipient, out.Recipient)
+	require.Equal(t, "partner", out.Referral)
+	require.Equal(t, sdk.MustNewDecFromStr("0.3"), out.Intents["cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"].Weight)
+	require.Equal(t, sdk.MustNewDecFromStr("0.7"), out.Intents["cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"].Weight)
+
+	ordinal := out.ToOrdinalIntents(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(1000))), zone.BaseDenom)
+	require.Equal(t, sdk.NewDec(300), ordinal["cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"].Weight)
+
+	// legacy memos are still supported.
+	out, err = zone.ParseMemo("WoS/+Ex92tEcuMBzhukZKMVnXKS8bqaQBJTx9zza4rrxyLiP9fwLijOc")
+	require.NoError(t, err)
+	require.Len(t, out.Intents, 2)
+	require.Empty(t, out.Recipient)
+
+	// an empty memo has no intents.
+	out, err = zone.ParseMemo("")
+	require.NoError(t, err)
+	require.Empty(t, out.Intents)
+}
+
+func TestEncodeMemoInvalid(t *testing.T) {
+	_, err := types.EncodeMemo([]*types.ValidatorIntent{{ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", Weight: sdk.MustNewDecFromStr("0.5")}}, nil, "")
+	require.ErrorContains(t, err, "sum to one")
+
+	_, err = types.EncodeMemo([]*types.ValidatorIntent{{ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", Weight: sdk.MustNewDecFromStr("0.0001")}}, nil, "")
+	require.ErrorContains(t, err, "multiple of 0.005")
+
+	_, err = types.EncodeMemo(nil, nil, "a-referral-tag-that-is-far-too-long")
+	require.ErrorContains(t, err, "referral")
+}
+
+func TestParseMemoInvalid(t *testing.T) {
+	zone := memoTestZone()
+	encode := func(bz ...byte) string { return base64.StdEncoding.EncodeToString(bz) }
+
+	tests := []struct {
+		name string
+		memo string
+		err  string
+	}{
+		{"invalid base64", "!!!", "base64"},
+		{"unsupported version", encode(0xf2), "unsupported memo version"},
+		{"truncated field", encode(types.MemoVersion1, types.MemoFieldReferral), "truncated"},
+		{"truncated value", encode(types.MemoVersion1, types.MemoFieldReferral, 5, 'a'), "truncated"},
+		{"unknown field", encode(types.MemoVersion1, 0x09, 1, 'a'), "unknown memo field"},
+		{"duplicate field", encode(types.MemoVersion1, types.MemoFieldReferral, 1, 'a', types.MemoFieldReferral, 1, 'b'), "duplicate"},
+		{"invalid legacy length", encode(1, 2, 3), "multiple of 21"},
+		{"unknown validator", encode(append([]byte{types.MemoVersion1, types.MemoFieldIntent, 21, 200}, make([]byte, 20)...)...), "unknown validator"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := zone.ParseMemo(tt.memo)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -26,7 +26,7 @@ func NewMsgRequestRedemption(coin string, destinationAddress string, fromAddress
 func (msg MsgRequestRedemption) Route() string { return RouterKey }
 
 // Type Implements Msg.
-func (msg MsgRequestRedemption) Type() string { return TypeMsgRegisterZone }
+func (msg MsgRequestRedemption) Type() string { return TypeMsgRequestRedemption }
 
 // ValidateBasic Implements Msg.
 func (msg MsgRequestRedemption) ValidateBasic() error {
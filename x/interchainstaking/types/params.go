@@ -18,6 +18,10 @@ var (
 	DefaultDelegationsInterval  uint64  = 200
 	DefaultValidatorSetInterval uint64  = 200
 	DefaultCommissionRate       sdk.Dec = func() sdk.Dec { v, _ := sdk.NewDecFromStr("0.02"); return v }()
+	DefaultCommunityPoolShare   sdk.Dec = sdk.ZeroDec()
+	DefaultTreasuryShare        sdk.Dec = sdk.ZeroDec()
+	DefaultTreasuryAddress              = ""
+	DefaultMaxMsgsPerTx         uint64  = 40
 
 	// KeyDelegateAccountCount is store's key for DelegateAccountCount option
 	KeyDelegateAccountCount = []byte("DelegateAccountCount")
@@ -33,6 +37,14 @@ var (
 	KeyValidatorSetInterval = []byte("ValidatorSetInterval")
 	// KeyCommissionRate is store's key for the CommissionRate option
 	KeyCommissionRate = []byte("CommissionRate")
+	// KeyCommunityPoolFeeShare is store's key for the CommunityPoolFeeShare option
+	KeyCommunityPoolFeeShare = []byte("CommunityPoolFeeShare")
+	// KeyTreasuryFeeShare is store's key for the TreasuryFeeShare option
+	KeyTreasuryFeeShare = []byte("TreasuryFeeShare")
+	// KeyTreasuryAddress is store's key for the TreasuryAddress option
+	KeyTreasuryAddress = []byte("TreasuryAddress")
+	// KeyMaxMsgsPerTx is store's key for the MaxMsgsPerTx option
+	KeyMaxMsgsPerTx = []byte("MaxMsgsPerTx")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -94,6 +106,30 @@ func validateParams(i interface{}) error {
 	if v.CommissionRate.IsNegative() {
 		return fmt.Errorf("commission rate must be non-negative: %s", v.CommissionRate.String())
 	}
+
+	if v.CommunityPoolFeeShare.IsNegative() {
+		return fmt.Errorf("community pool fee share must be non-negative: %s", v.CommunityPoolFeeShare.String())
+	}
+
+	if v.TreasuryFeeShare.IsNegative() {
+		return fmt.Errorf("treasury fee share must be non-negative: %s", v.TreasuryFeeShare.String())
+	}
+
+	if v.CommunityPoolFeeShare.Add(v.TreasuryFeeShare).GT(sdk.OneDec()) {
+		return fmt.Errorf("community pool and treasury fee shares must not exceed 1: %s", v.CommunityPoolFeeShare.Add(v.TreasuryFeeShare).String())
+	}
+
+	if err := validateAddress(v.TreasuryAddress); err != nil {
+		return err
+	}
+
+	if v.TreasuryFeeShare.IsPositive() && v.TreasuryAddress == "" {
+		return fmt.Errorf("treasury address must be set when treasury fee share is positive")
+	}
+
+	if v.MaxMsgsPerTx <= 0 {
+		return fmt.Errorf("max msgs per tx must be positive: %d", v.MaxMsgsPerTx)
+	}
 	return nil
 }
 
@@ -106,6 +142,10 @@ func NewParams(
 	delegationsInterval uint64,
 	valsetInterval uint64,
 	commissionRate sdk.Dec,
+	communityPoolFeeShare sdk.Dec,
+	treasuryFeeShare sdk.Dec,
+	treasuryAddress string,
+	maxMsgsPerTx uint64,
 ) Params {
 	return Params{
 		DelegationAccountCount: delegateAccountCount,
@@ -115,6 +155,10 @@ func NewParams(
 		DelegationsInterval:    delegationsInterval,
 		ValidatorsetInterval:   valsetInterval,
 		CommissionRate:         commissionRate,
+		CommunityPoolFeeShare:  communityPoolFeeShare,
+		TreasuryFeeShare:       treasuryFeeShare,
+		TreasuryAddress:        treasuryAddress,
+		MaxMsgsPerTx:           maxMsgsPerTx,
 	}
 }
 
@@ -128,6 +172,10 @@ func DefaultParams() Params {
 		DefaultDelegationsInterval,
 		DefaultValidatorSetInterval,
 		DefaultCommissionRate,
+		DefaultCommunityPoolShare,
+		DefaultTreasuryShare,
+		DefaultTreasuryAddress,
+		DefaultMaxMsgsPerTx,
 	)
 }
 
@@ -146,6 +194,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDelegationsInterval, &p.DelegationsInterval, validatePositiveInt),
 		paramtypes.NewParamSetPair(KeyValidatorSetInterval, &p.ValidatorsetInterval, validatePositiveInt),
 		paramtypes.NewParamSetPair(KeyCommissionRate, &p.CommissionRate, validateNonNegativeDec),
+		paramtypes.NewParamSetPair(KeyCommunityPoolFeeShare, &p.CommunityPoolFeeShare, validateFeeShare),
+		paramtypes.NewParamSetPair(KeyTreasuryFeeShare, &p.TreasuryFeeShare, validateFeeShare),
+		paramtypes.NewParamSetPair(KeyTreasuryAddress, &p.TreasuryAddress, validateAddress),
+		paramtypes.NewParamSetPair(KeyMaxMsgsPerTx, &p.MaxMsgsPerTx, validatePositiveInt),
 	}
 }
 
@@ -177,3 +229,31 @@ func validateNonNegativeDec(i interface{}) error {
 	}
 	return nil
 }
+
+func validateFeeShare(i interface{}) error {
+	share, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if share.IsNegative() || share.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid fee share, must be between 0 and 1: %s", share)
+	}
+	return nil
+}
+
+func validateAddress(i interface{}) error {
+	address, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if address == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return fmt.Errorf("invalid address %q: %w", address, err)
+	}
+	return nil
+}
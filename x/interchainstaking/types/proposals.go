@@ -2,20 +2,45 @@ package types
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
-	ProposalTypeRegisterZone = "RegisterZone"
-	ProposalTypeUpdateZone   = "UpdateZone"
+	ProposalTypeRegisterZone   = "RegisterZone"
+	ProposalTypeUpdateZone     = "UpdateZone"
+	ProposalTypeDeregisterZone = "DeregisterZone"
+	ProposalTypeResumeZone     = "ResumeZone"
+)
+
+// keys supported by UpdateZoneProposal. The connection of a zone cannot be updated, as its interchain accounts are
+// bound to it.
+const (
+	UpdateZoneKeyBaseDenom                    = "base_denom"
+	UpdateZoneKeyMultiSend                    = "multi_send"
+	UpdateZoneKeyLiquidityModule              = "liquidity_module"
+	UpdateZoneKeyAccountPrefix                = "account_prefix"
+	UpdateZoneKeyValidatorSelectionAllocation = "validator_selection_allocation"
+	UpdateZoneKeyHoldingsAllocation           = "holdings_allocation"
+	UpdateZoneKeyCommissionRate               = "commission_rate"
+	UpdateZoneKeyMaxRedemptionRateChange      = "max_redemption_rate_change"
+	UpdateZoneKeyMinRedemptionRate            = "min_redemption_rate"
+	UpdateZoneKeyMaxRedemptionRate            = "max_redemption_rate"
+	UpdateZoneKeyState                        = "state"
+	UpdateZoneKeyValidatorDenylist            = "validator_denylist"
+	UpdateZoneKeyValidatorAllowlist           = "validator_allowlist"
+	UpdateZoneKeyDelegationAccountCount       = "delegation_account_count"
 )
 
 var (
 	_ govtypes.Content = &RegisterZoneProposal{}
 	_ govtypes.Content = &UpdateZoneProposal{}
+	_ govtypes.Content = &DeregisterZoneProposal{}
+	_ govtypes.Content = &ResumeZoneProposal{}
 )
 
 func NewRegisterZoneProposal(title string, description string, connectionID string, baseDenom string, localDenom string, accountPrefix string, multiSend bool, liquidityModule bool) *RegisterZoneProposal {
@@ -87,6 +112,23 @@ func (m UpdateZoneProposal) ValidateBasic() error {
 		return err
 	}
 
+	if len(m.ChainId) == 0 {
+		return fmt.Errorf("chain id must not be empty")
+	}
+
+	if len(m.Changes) == 0 {
+		return fmt.Errorf("proposal must contain at least one change")
+	}
+
+	for _, change := range m.Changes {
+		if change == nil {
+			return fmt.Errorf("change must not be nil")
+		}
+		if err := change.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -108,6 +150,147 @@ func (m UpdateZoneProposal) String() string {
 	return b.String()
 }
 
+// Validate checks that the key is supported, and that the value is valid for the key.
 func (v UpdateZoneValue) Validate() error {
+	switch v.Key {
+	case UpdateZoneKeyBaseDenom:
+		return sdk.ValidateDenom(v.Value)
+	case UpdateZoneKeyMultiSend, UpdateZoneKeyLiquidityModule:
+		if _, err := strconv.ParseBool(v.Value); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", v.Key, err)
+		}
+	case UpdateZoneKeyAccountPrefix:
+		if len(v.Value) < 2 {
+			return fmt.Errorf("account prefix must be at least 2 characters") // ki is shortest to date.
+		}
+	case UpdateZoneKeyValidatorSelectionAllocation, UpdateZoneKeyHoldingsAllocation:
+		if _, err := sdk.ParseCoinsNormalized(v.Value); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", v.Key, err)
+		}
+	case UpdateZoneKeyCommissionRate:
+		rate, err := sdk.NewDecFromStr(v.Value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", v.Key, err)
+		}
+		if rate.IsNegative() || rate.GT(sdk.OneDec()) {
+			return fmt.Errorf("commission rate must be between 0 and 1: %s", rate)
+		}
+	case UpdateZoneKeyMaxRedemptionRateChange, UpdateZoneKeyMinRedemptionRate, UpdateZoneKeyMaxRedemptionRate:
+		rate, err := sdk.NewDecFromStr(v.Value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", v.Key, err)
+		}
+		if rate.IsNegative() {
+			return fmt.Errorf("%s must be non-negative: %s", v.Key, rate)
+		}
+	case UpdateZoneKeyState:
+		if _, err := ParseZoneState(v.Value); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", v.Key, err)
+		}
+	case UpdateZoneKeyValidatorDenylist, UpdateZoneKeyValidatorAllowlist:
+		if _, err := ParseValidatorList(v.Value); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", v.Key, err)
+		}
+	case UpdateZoneKeyDelegationAccountCount:
+		count, err := strconv.ParseUint(v.Value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", v.Key, err)
+		}
+		if count == 0 {
+			return fmt.Errorf("%s must be positive", v.Key)
+		}
+	default:
+		return fmt.Errorf("unexpected key %q", v.Key)
+	}
+	return nil
+}
+
+// ParseValidatorList parses a comma separated list of validator operator addresses. An empty value yields an empty
+// list.
+func ParseValidatorList(value string) ([]string, error) {
+	out := []string{}
+	seen := map[string]bool{}
+	for _, valoper := range strings.Split(value, ",") {
+		valoper = strings.TrimSpace(valoper)
+		if valoper == "" {
+			continue
+		}
+		if _, _, err := bech32.DecodeAndConvert(valoper); err != nil {
+			return nil, fmt.Errorf("invalid validator address %s: %w", valoper, err)
+		}
+		if seen[valoper] {
+			return nil, fmt.Errorf("duplicate validator address %s", valoper)
+		}
+		seen[valoper] = true
+		out = append(out, valoper)
+	}
+	return out, nil
+}
+
+func NewDeregisterZoneProposal(title string, description string, chainID string) *DeregisterZoneProposal {
+	return &DeregisterZoneProposal{Title: title, Description: description, ChainId: chainID}
+}
+
+func (m DeregisterZoneProposal) GetDescription() string { return m.Description }
+func (m DeregisterZoneProposal) GetTitle() string       { return m.Title }
+func (m DeregisterZoneProposal) ProposalRoute() string  { return RouterKey }
+func (m DeregisterZoneProposal) ProposalType() string   { return ProposalTypeDeregisterZone }
+
+// ValidateBasic runs basic stateless validity checks
+func (m DeregisterZoneProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(m)
+	if err != nil {
+		return err
+	}
+
+	if len(m.ChainId) == 0 {
+		return fmt.Errorf("chain id must not be empty")
+	}
+
 	return nil
 }
+
+// String implements the Stringer interface.
+func (m DeregisterZoneProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Interchain Staking Zone Deregistration Proposal:
+  Title:       %s
+  Description: %s
+  Chain Id:    %s
+`, m.Title, m.Description, m.ChainId))
+	return b.String()
+}
+
+func NewResumeZoneProposal(title string, description string, chainID string) *ResumeZoneProposal {
+	return &ResumeZoneProposal{Title: title, Description: description, ChainId: chainID}
+}
+
+func (m ResumeZoneProposal) GetDescription() string { return m.Description }
+func (m ResumeZoneProposal) GetTitle() string       { return m.Title }
+func (m ResumeZoneProposal) ProposalRoute() string  { return RouterKey }
+func (m ResumeZoneProposal) ProposalType() string   { return ProposalTypeResumeZone }
+
+// ValidateBasic runs basic stateless validity checks
+func (m ResumeZoneProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(m)
+	if err != nil {
+		return err
+	}
+
+	if len(m.ChainId) == 0 {
+		return fmt.Errorf("chain id must not be empty")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (m ResumeZoneProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Interchain Staking Zone Resume Proposal:
+  Title:       %s
+  Description: %s
+  Chain Id:    %s
+`, m.Title, m.Description, m.ChainId))
+	return b.String()
+}
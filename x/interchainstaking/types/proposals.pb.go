@@ -193,6 +193,162 @@ func (m *UpdateZoneProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateZoneProposalWithDeposit proto.InternalMessageInfo
 
+type DeregisterZoneProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ChainId     string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+}
+
+func (m *DeregisterZoneProposal) Reset()      { *m = DeregisterZoneProposal{} }
+func (*DeregisterZoneProposal) ProtoMessage() {}
+func (*DeregisterZoneProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{4}
+}
+func (m *DeregisterZoneProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterZoneProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterZoneProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregisterZoneProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterZoneProposal.Merge(m, src)
+}
+func (m *DeregisterZoneProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterZoneProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterZoneProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterZoneProposal proto.InternalMessageInfo
+
+type DeregisterZoneProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ChainId     string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	Deposit     string `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *DeregisterZoneProposalWithDeposit) Reset()         { *m = DeregisterZoneProposalWithDeposit{} }
+func (m *DeregisterZoneProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*DeregisterZoneProposalWithDeposit) ProtoMessage()    {}
+func (*DeregisterZoneProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{5}
+}
+func (m *DeregisterZoneProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterZoneProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterZoneProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregisterZoneProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterZoneProposalWithDeposit.Merge(m, src)
+}
+func (m *DeregisterZoneProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterZoneProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterZoneProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterZoneProposalWithDeposit proto.InternalMessageInfo
+
+type ResumeZoneProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ChainId     string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+}
+
+func (m *ResumeZoneProposal) Reset()      { *m = ResumeZoneProposal{} }
+func (*ResumeZoneProposal) ProtoMessage() {}
+func (*ResumeZoneProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{6}
+}
+func (m *ResumeZoneProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeZoneProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeZoneProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeZoneProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeZoneProposal.Merge(m, src)
+}
+func (m *ResumeZoneProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeZoneProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeZoneProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeZoneProposal proto.InternalMessageInfo
+
+type ResumeZoneProposalWithDeposit struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ChainId     string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	Deposit     string `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *ResumeZoneProposalWithDeposit) Reset()         { *m = ResumeZoneProposalWithDeposit{} }
+func (m *ResumeZoneProposalWithDeposit) String() string { return proto.CompactTextString(m) }
+func (*ResumeZoneProposalWithDeposit) ProtoMessage()    {}
+func (*ResumeZoneProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04d034c830a7acfe, []int{7}
+}
+func (m *ResumeZoneProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeZoneProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeZoneProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeZoneProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeZoneProposalWithDeposit.Merge(m, src)
+}
+func (m *ResumeZoneProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeZoneProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeZoneProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeZoneProposalWithDeposit proto.InternalMessageInfo
+
 // ParamChange defines an individual parameter change, for use in
 // ParameterChangeProposal.
 type UpdateZoneValue struct {
@@ -204,7 +360,7 @@ func (m *UpdateZoneValue) Reset()         { *m = UpdateZoneValue{} }
 func (m *UpdateZoneValue) String() string { return proto.CompactTextString(m) }
 func (*UpdateZoneValue) ProtoMessage()    {}
 func (*UpdateZoneValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_04d034c830a7acfe, []int{4}
+	return fileDescriptor_04d034c830a7acfe, []int{8}
 }
 func (m *UpdateZoneValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,6 +408,10 @@ func init() {
 	proto.RegisterType((*RegisterZoneProposalWithDeposit)(nil), "quicksilver.interchainstaking.v1.RegisterZoneProposalWithDeposit")
 	proto.RegisterType((*UpdateZoneProposal)(nil), "quicksilver.interchainstaking.v1.UpdateZoneProposal")
 	proto.RegisterType((*UpdateZoneProposalWithDeposit)(nil), "quicksilver.interchainstaking.v1.UpdateZoneProposalWithDeposit")
+	proto.RegisterType((*DeregisterZoneProposal)(nil), "quicksilver.interchainstaking.v1.DeregisterZoneProposal")
+	proto.RegisterType((*DeregisterZoneProposalWithDeposit)(nil), "quicksilver.interchainstaking.v1.DeregisterZoneProposalWithDeposit")
+	proto.RegisterType((*ResumeZoneProposal)(nil), "quicksilver.interchainstaking.v1.ResumeZoneProposal")
+	proto.RegisterType((*ResumeZoneProposalWithDeposit)(nil), "quicksilver.interchainstaking.v1.ResumeZoneProposalWithDeposit")
 	proto.RegisterType((*UpdateZoneValue)(nil), "quicksilver.interchainstaking.v1.UpdateZoneValue")
 }
 
@@ -260,51 +420,54 @@ func init() {
 }
 
 var fileDescriptor_04d034c830a7acfe = []byte{
-	// 691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x4d, 0x9a, 0xfe, 0x24, 0xd3, 0xdf, 0x6f, 0xbe, 0x14, 0xdc, 0x42, 0xe3, 0x68, 0x16, 0xa8,
-	0x48, 0x60, 0x53, 0xa8, 0x04, 0xaa, 0x84, 0x54, 0x45, 0x15, 0x52, 0x17, 0x48, 0x95, 0x11, 0x20,
-	0x95, 0x85, 0xe5, 0xd8, 0x83, 0x3b, 0x8a, 0x33, 0xe3, 0x7a, 0xc6, 0x51, 0xf3, 0x06, 0x5d, 0xb2,
-	0x01, 0xb1, 0xec, 0x96, 0x37, 0x41, 0xac, 0xba, 0x64, 0x65, 0xa1, 0x76, 0xc3, 0xda, 0x4f, 0x80,
-	0x3c, 0xe3, 0x10, 0xa7, 0x09, 0x42, 0x2c, 0x40, 0x48, 0xec, 0xee, 0xbd, 0xe7, 0x9e, 0xc9, 0xcd,
-	0x39, 0x77, 0x3c, 0xe0, 0xde, 0x71, 0x4c, 0xdc, 0x0e, 0x27, 0x41, 0x0f, 0x47, 0x26, 0xa1, 0x02,
-	0x47, 0xee, 0x91, 0x43, 0x28, 0x17, 0x4e, 0x87, 0x50, 0xdf, 0xec, 0x6d, 0x99, 0x61, 0xc4, 0x42,
-	0xc6, 0x9d, 0x80, 0x1b, 0x61, 0xc4, 0x04, 0x83, 0xcd, 0x02, 0xc3, 0x18, 0x63, 0x18, 0xbd, 0xad,
-	0xf5, 0xba, 0xcf, 0x7c, 0x26, 0x9b, 0xcd, 0x2c, 0x52, 0xbc, 0xf5, 0x35, 0x97, 0xf1, 0x2e, 0xe3,
-	0xb6, 0x02, 0x54, 0x92, 0x43, 0x37, 0x7d, 0xc6, 0xfc, 0x00, 0x9b, 0x4e, 0x48, 0x4c, 0x87, 0x52,
-	0x26, 0x1c, 0x41, 0x18, 0xcd, 0x51, 0xf4, 0xae, 0x02, 0xea, 0x16, 0xf6, 0x09, 0x17, 0x38, 0x3a,
-	0x64, 0x14, 0x1f, 0xe4, 0x03, 0xc1, 0x3a, 0x98, 0x11, 0x44, 0x04, 0x58, 0x2b, 0x37, 0xcb, 0x9b,
-	0x35, 0x4b, 0x25, 0xb0, 0x09, 0xe6, 0x3d, 0xcc, 0xdd, 0x88, 0x84, 0xd9, 0x21, 0xda, 0x94, 0xc4,
-	0x8a, 0x25, 0xf8, 0x18, 0x2c, 0xba, 0x8c, 0x52, 0xec, 0x66, 0x99, 0x4d, 0x3c, 0xad, 0x92, 0xf5,
-	0xb4, 0xb4, 0x34, 0xd1, 0xeb, 0x7d, 0xa7, 0x1b, 0xec, 0xa0, 0x11, 0x18, 0x59, 0x0b, 0xc3, 0x7c,
-	0xdf, 0x83, 0xdb, 0x00, 0xb4, 0x1d, 0x8e, 0x6d, 0x0f, 0x53, 0xd6, 0xd5, 0xa6, 0x25, 0x77, 0x35,
-	0x4d, 0xf4, 0xff, 0x14, 0x77, 0x88, 0x21, 0xab, 0x96, 0x25, 0x7b, 0x59, 0x0c, 0x1f, 0x82, 0xf9,
-	0x80, 0xb9, 0x4e, 0x90, 0xd3, 0x66, 0x24, 0xed, 0x5a, 0x9a, 0xe8, 0x50, 0xd1, 0x0a, 0x20, 0xb2,
-	0x80, 0xcc, 0x14, 0x71, 0x17, 0x2c, 0x39, 0xae, 0xcb, 0x62, 0x2a, 0xec, 0x30, 0xc2, 0xaf, 0xc9,
-	0x89, 0x36, 0x2b, 0xb9, 0x6b, 0x69, 0xa2, 0xaf, 0x2a, 0xee, 0x28, 0x8e, 0xac, 0xc5, 0xbc, 0x70,
-	0x20, 0x73, 0xb8, 0x01, 0x40, 0x37, 0x0e, 0x04, 0xb1, 0x39, 0xa6, 0x9e, 0x36, 0xd7, 0x2c, 0x6f,
-	0x56, 0xad, 0x9a, 0xac, 0x3c, 0xc3, 0xd4, 0x83, 0xb7, 0xc1, 0x4a, 0x40, 0x8e, 0x63, 0xe2, 0x11,
-	0xd1, 0xb7, 0xbb, 0xcc, 0x8b, 0x03, 0xac, 0x55, 0x65, 0xd3, 0xf2, 0xf7, 0xfa, 0x53, 0x59, 0xde,
-	0x59, 0x38, 0x3d, 0xd3, 0x4b, 0xef, 0xcf, 0xf4, 0xd2, 0xd7, 0x33, 0xbd, 0x84, 0x3e, 0x4c, 0x03,
-	0x7d, 0x92, 0x31, 0x2f, 0x89, 0x38, 0xda, 0xc3, 0x21, 0xe3, 0x44, 0xc0, 0x5b, 0x23, 0x1e, 0xb5,
-	0x56, 0xd2, 0x44, 0x5f, 0x50, 0x43, 0xcb, 0x32, 0x1a, 0xb8, 0xf6, 0x68, 0x82, 0x6b, 0x45, 0x79,
-	0x0a, 0x20, 0xfa, 0xb7, 0xdd, 0xdc, 0x1e, 0x77, 0xb3, 0x38, 0xf0, 0x10, 0x43, 0x45, 0x93, 0x9f,
-	0xfc, 0xc8, 0xe4, 0xd6, 0x8d, 0x34, 0xd1, 0xaf, 0xe7, 0x53, 0x5f, 0xe9, 0x40, 0x63, 0x1b, 0x00,
-	0xef, 0x80, 0x39, 0x4f, 0x59, 0xab, 0xd5, 0xe4, 0xe0, 0x30, 0x4d, 0xf4, 0xa5, 0x81, 0x47, 0x12,
-	0x40, 0xd6, 0xa0, 0x65, 0xa7, 0x7a, 0x3a, 0xd8, 0x95, 0xb7, 0x53, 0x00, 0x3e, 0x0f, 0x3d, 0x47,
-	0xe0, 0x91, 0x2b, 0xfc, 0xfb, 0xd7, 0xc3, 0x00, 0x55, 0xf9, 0x7d, 0x1a, 0x6e, 0xc6, 0xff, 0x69,
-	0xa2, 0x2f, 0xe7, 0x9b, 0x91, 0x23, 0xc8, 0x9a, 0x93, 0xe1, 0xbe, 0x07, 0x6d, 0x90, 0x85, 0xd4,
-	0xc7, 0x5c, 0x9b, 0x6e, 0x56, 0x36, 0xe7, 0xef, 0x6f, 0x19, 0x3f, 0xfb, 0xe0, 0x19, 0xc3, 0x3f,
-	0xf6, 0xc2, 0x09, 0x62, 0x5c, 0xd4, 0x24, 0x3f, 0x4b, 0xfd, 0x40, 0x16, 0x5d, 0xb9, 0x43, 0x9f,
-	0xa6, 0xc0, 0xc6, 0xb8, 0x2e, 0x7f, 0xf6, 0x06, 0xfd, 0x6d, 0x12, 0x15, 0x97, 0x6c, 0xe6, 0x57,
-	0x96, 0xec, 0x15, 0x58, 0xbe, 0xf2, 0x3b, 0xb0, 0x09, 0x2a, 0x1d, 0xdc, 0xcf, 0xb5, 0x5b, 0x4a,
-	0x13, 0x1d, 0xa8, 0x63, 0x3a, 0xb8, 0x8f, 0xac, 0x0c, 0xca, 0xf4, 0xed, 0x65, 0xad, 0xb9, 0x62,
-	0x05, 0x7d, 0x65, 0x19, 0x59, 0x0a, 0x6e, 0x1d, 0x7e, 0xbc, 0x68, 0x94, 0xcf, 0x2f, 0x1a, 0xe5,
-	0x2f, 0x17, 0x8d, 0xf2, 0x9b, 0xcb, 0x46, 0xe9, 0xfc, 0xb2, 0x51, 0xfa, 0x7c, 0xd9, 0x28, 0x1d,
-	0xee, 0xfa, 0x44, 0x1c, 0xc5, 0x6d, 0xc3, 0x65, 0x5d, 0x93, 0x50, 0x1f, 0xd3, 0x98, 0x88, 0xfe,
-	0xdd, 0x76, 0x4c, 0x02, 0xcf, 0x2c, 0x3e, 0xaf, 0x27, 0x13, 0x1e, 0x58, 0xd1, 0x0f, 0x31, 0x6f,
-	0xcf, 0xca, 0x97, 0xee, 0xc1, 0xb7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xc6, 0x3c, 0xe1, 0x8e,
-	0x07, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8e, 0x93, 0xb4, 0x4d, 0xae, 0x7f, 0x7f, 0xf7, 0x4b, 0x8b, 0x5b, 0x68, 0x1c, 0x6e, 0x40,
+	0x45, 0x82, 0x98, 0x42, 0x25, 0x50, 0x25, 0xa4, 0x2a, 0xaa, 0x90, 0x3a, 0x20, 0x55, 0x46, 0x80,
+	0x54, 0x86, 0xc8, 0xb1, 0x0f, 0xf7, 0x14, 0xfb, 0xce, 0xb5, 0xcf, 0x51, 0xf3, 0x0d, 0x3a, 0xb2,
+	0x80, 0x18, 0xbb, 0xb2, 0x33, 0xf0, 0x11, 0x10, 0x53, 0x47, 0x26, 0x0b, 0xb5, 0x0b, 0x0c, 0x2c,
+	0xfe, 0x04, 0xc8, 0x67, 0x87, 0x38, 0x7f, 0x10, 0xea, 0x00, 0x2a, 0xb0, 0xdd, 0xfb, 0x3e, 0xef,
+	0x73, 0x79, 0xef, 0x7d, 0x9e, 0x73, 0x0e, 0xdc, 0x3a, 0x08, 0x88, 0xd1, 0xf6, 0x89, 0xdd, 0xc1,
+	0x9e, 0x4a, 0x28, 0xc7, 0x9e, 0xb1, 0xaf, 0x13, 0xea, 0x73, 0xbd, 0x4d, 0xa8, 0xa5, 0x76, 0xd6,
+	0x55, 0xd7, 0x63, 0x2e, 0xf3, 0x75, 0xdb, 0xaf, 0xbb, 0x1e, 0xe3, 0x0c, 0xd6, 0x32, 0x8c, 0xfa,
+	0x08, 0xa3, 0xde, 0x59, 0x5f, 0xa9, 0x58, 0xcc, 0x62, 0xa2, 0x58, 0x8d, 0x57, 0x09, 0x6f, 0x65,
+	0xd9, 0x60, 0xbe, 0xc3, 0xfc, 0x66, 0x02, 0x24, 0x41, 0x0a, 0x5d, 0xb1, 0x18, 0xb3, 0x6c, 0xac,
+	0xea, 0x2e, 0x51, 0x75, 0x4a, 0x19, 0xd7, 0x39, 0x61, 0x34, 0x45, 0xd1, 0xab, 0x02, 0xa8, 0x68,
+	0xd8, 0x22, 0x3e, 0xc7, 0xde, 0x1e, 0xa3, 0x78, 0x37, 0x6d, 0x08, 0x56, 0xc0, 0x04, 0x27, 0xdc,
+	0xc6, 0xb2, 0x54, 0x93, 0xd6, 0xca, 0x5a, 0x12, 0xc0, 0x1a, 0x98, 0x36, 0xb1, 0x6f, 0x78, 0xc4,
+	0x8d, 0x37, 0x91, 0xf3, 0x02, 0xcb, 0xa6, 0xe0, 0x7d, 0x30, 0x6b, 0x30, 0x4a, 0xb1, 0x11, 0x47,
+	0x4d, 0x62, 0xca, 0x85, 0xb8, 0xa6, 0x21, 0x47, 0xa1, 0x52, 0xe9, 0xea, 0x8e, 0xbd, 0x89, 0x06,
+	0x60, 0xa4, 0xcd, 0xf4, 0xe3, 0x1d, 0x13, 0x6e, 0x00, 0xd0, 0xd2, 0x7d, 0xdc, 0x34, 0x31, 0x65,
+	0x8e, 0x5c, 0x14, 0xdc, 0xc5, 0x28, 0x54, 0xfe, 0x4b, 0xb8, 0x7d, 0x0c, 0x69, 0xe5, 0x38, 0xd8,
+	0x8e, 0xd7, 0xf0, 0x2e, 0x98, 0xb6, 0x99, 0xa1, 0xdb, 0x29, 0x6d, 0x42, 0xd0, 0x96, 0xa2, 0x50,
+	0x81, 0x09, 0x2d, 0x03, 0x22, 0x0d, 0x88, 0x28, 0x21, 0x6e, 0x81, 0x39, 0xdd, 0x30, 0x58, 0x40,
+	0x79, 0xd3, 0xf5, 0xf0, 0x73, 0x72, 0x28, 0x4f, 0x0a, 0xee, 0x72, 0x14, 0x2a, 0x8b, 0x09, 0x77,
+	0x10, 0x47, 0xda, 0x6c, 0x9a, 0xd8, 0x15, 0x31, 0x5c, 0x05, 0xc0, 0x09, 0x6c, 0x4e, 0x9a, 0x3e,
+	0xa6, 0xa6, 0x3c, 0x55, 0x93, 0xd6, 0x4a, 0x5a, 0x59, 0x64, 0x1e, 0x61, 0x6a, 0xc2, 0xeb, 0x60,
+	0xc1, 0x26, 0x07, 0x01, 0x31, 0x09, 0xef, 0x36, 0x1d, 0x66, 0x06, 0x36, 0x96, 0x4b, 0xa2, 0x68,
+	0xfe, 0x7b, 0xfe, 0xa1, 0x48, 0x6f, 0xce, 0x1c, 0x1d, 0x2b, 0xb9, 0xd7, 0xc7, 0x4a, 0xee, 0xf3,
+	0xb1, 0x92, 0x43, 0x6f, 0x8a, 0x40, 0x19, 0x27, 0xcc, 0x53, 0xc2, 0xf7, 0xb7, 0xb1, 0xcb, 0x7c,
+	0xc2, 0xe1, 0xb5, 0x01, 0x8d, 0x1a, 0x0b, 0x51, 0xa8, 0xcc, 0x24, 0x4d, 0x8b, 0x34, 0xea, 0xa9,
+	0x76, 0x6f, 0x8c, 0x6a, 0xd9, 0xf1, 0x64, 0x40, 0xf4, 0x6f, 0xab, 0xb9, 0x31, 0xaa, 0x66, 0xb6,
+	0xe1, 0x3e, 0x86, 0xb2, 0x22, 0x3f, 0xf8, 0x91, 0xc8, 0x8d, 0xcb, 0x51, 0xa8, 0x5c, 0x4a, 0xbb,
+	0x1e, 0xaa, 0x40, 0x23, 0x0e, 0x80, 0x37, 0xc0, 0x94, 0x99, 0x48, 0x2b, 0x97, 0x45, 0xe3, 0x30,
+	0x0a, 0x95, 0xb9, 0x9e, 0x46, 0x02, 0x40, 0x5a, 0xaf, 0x64, 0xb3, 0x74, 0xd4, 0xf3, 0xca, 0xcb,
+	0x3c, 0x80, 0x8f, 0x5d, 0x53, 0xe7, 0x78, 0xe0, 0x0a, 0xff, 0x7a, 0x7b, 0xd4, 0x41, 0x49, 0x7c,
+	0x9f, 0xfa, 0xce, 0xf8, 0x3f, 0x0a, 0x95, 0xf9, 0xd4, 0x19, 0x29, 0x82, 0xb4, 0x29, 0xb1, 0xdc,
+	0x31, 0x61, 0x13, 0xc4, 0x4b, 0x6a, 0x61, 0x5f, 0x2e, 0xd6, 0x0a, 0x6b, 0xd3, 0xb7, 0xd7, 0xeb,
+	0x3f, 0xfb, 0xe0, 0xd5, 0xfb, 0x07, 0x7b, 0xa2, 0xdb, 0x01, 0xce, 0xce, 0x24, 0xdd, 0x2b, 0xf9,
+	0x81, 0x78, 0x35, 0x74, 0x87, 0x3e, 0xe4, 0xc1, 0xea, 0xe8, 0x5c, 0x7e, 0xef, 0x0d, 0xba, 0x68,
+	0x23, 0xca, 0x9a, 0x6c, 0xe2, 0x3c, 0x26, 0x7b, 0x27, 0x81, 0xa5, 0x6d, 0xec, 0x8d, 0xfb, 0xaf,
+	0xb8, 0x70, 0x53, 0x1c, 0xf2, 0xc1, 0x57, 0x09, 0x5c, 0x1d, 0xdf, 0xfa, 0xc5, 0xf6, 0x42, 0x46,
+	0xaa, 0xe2, 0x79, 0xa4, 0x7a, 0x2b, 0x01, 0xa8, 0x61, 0x3f, 0x70, 0xf0, 0x1f, 0x25, 0xd3, 0x17,
+	0x09, 0xac, 0x8e, 0xb6, 0xfd, 0x77, 0x4a, 0xf4, 0x0c, 0xcc, 0x0f, 0xdd, 0x5a, 0x58, 0x03, 0x85,
+	0x36, 0xee, 0xa6, 0x47, 0x9b, 0x8b, 0x42, 0x05, 0x24, 0xdb, 0xb4, 0x71, 0x17, 0x69, 0x31, 0x14,
+	0x1f, 0xbf, 0x13, 0x97, 0xca, 0xf9, 0xe1, 0xe3, 0x8b, 0x34, 0xd2, 0x12, 0xb8, 0xb1, 0xf7, 0xfe,
+	0xb4, 0x2a, 0x9d, 0x9c, 0x56, 0xa5, 0x4f, 0xa7, 0x55, 0xe9, 0xc5, 0x59, 0x35, 0x77, 0x72, 0x56,
+	0xcd, 0x7d, 0x3c, 0xab, 0xe6, 0xf6, 0xb6, 0x2c, 0xc2, 0xf7, 0x83, 0x56, 0xdd, 0x60, 0x8e, 0x4a,
+	0xa8, 0x85, 0x69, 0x40, 0x78, 0xf7, 0x66, 0x2b, 0x20, 0xb6, 0xa9, 0x66, 0x1f, 0xab, 0x87, 0x63,
+	0x9e, 0xab, 0xbc, 0xeb, 0x62, 0xbf, 0x35, 0x29, 0xde, 0x8d, 0x77, 0xbe, 0x0d, 0x00, 0x71, 0x39,
+	0xdb, 0x65, 0xdc, 0x0a, 0x00, 0x00,
 }
 
 func (m *RegisterZoneProposal) Marshal() (dAtA []byte, err error) {
@@ -607,7 +770,7 @@ func (m *UpdateZoneProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateZoneValue) Marshal() (dAtA []byte, err error) {
+func (m *DeregisterZoneProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -617,100 +780,290 @@ func (m *UpdateZoneValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateZoneValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeregisterZoneProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateZoneValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeregisterZoneProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Value)))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintProposals(dAtA, i, uint64(len(m.Key)))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposals(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DeregisterZoneProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *RegisterZoneProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DeregisterZoneProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterZoneProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.LocalDenom)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeZoneProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.AccountPrefix)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ResumeZoneProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeZoneProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MultiSend {
-		n += 2
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.LiquidityModule {
-		n += 2
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisterZoneProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResumeZoneProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeZoneProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeZoneProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovProposals(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateZoneValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateZoneValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateZoneValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposals(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegisterZoneProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.LocalDenom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.AccountPrefix)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.MultiSend {
+		n += 2
+	}
+	if m.LiquidityModule {
+		n += 2
+	}
+	return n
+}
+
+func (m *RegisterZoneProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
 	}
 	l = len(m.BaseDenom)
 	if l > 0 {
@@ -795,6 +1148,98 @@ func (m *UpdateZoneProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *DeregisterZoneProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *DeregisterZoneProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *ResumeZoneProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
+func (m *ResumeZoneProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
 func (m *UpdateZoneValue) Size() (n int) {
 	if m == nil {
 		return 0
@@ -945,7 +1390,687 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MultiSend = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidityModule = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterZoneProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterZoneProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MultiSend = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidityModule = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateZoneProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateZoneProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &UpdateZoneValue{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateZoneProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateZoneProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -973,11 +2098,11 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1005,11 +2130,11 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1037,13 +2162,13 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1053,17 +2178,31 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MultiSend = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &UpdateZoneValue{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposals
@@ -1073,12 +2212,24 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LiquidityModule = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -1100,7 +2251,7 @@ func (m *RegisterZoneProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *DeregisterZoneProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1123,10 +2274,10 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterZoneProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeregisterZoneProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterZoneProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeregisterZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1195,7 +2346,7 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1223,11 +2374,61 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterZoneProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterZoneProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1255,11 +2456,11 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1287,11 +2488,11 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1319,49 +2520,9 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountPrefix = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiSend", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.MultiSend = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityModule", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.LiquidityModule = bool(v != 0)
-		case 9:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
@@ -1414,7 +2575,7 @@ func (m *RegisterZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateZoneProposal) Unmarshal(dAtA []byte) error {
+func (m *ResumeZoneProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1437,10 +2598,10 @@ func (m *UpdateZoneProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateZoneProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResumeZoneProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResumeZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1539,40 +2700,6 @@ func (m *UpdateZoneProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Changes = append(m.Changes, &UpdateZoneValue{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -1594,7 +2721,7 @@ func (m *UpdateZoneProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *ResumeZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1617,10 +2744,10 @@ func (m *UpdateZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateZoneProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResumeZoneProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateZoneProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResumeZoneProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1720,40 +2847,6 @@ func (m *UpdateZoneProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposals
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposals
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposals
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Changes = append(m.Changes, &UpdateZoneValue{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestUpdateZoneProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		changes []*types.UpdateZoneValue
+		valid   bool
+	}{
+		{"base denom", []*types.UpdateZoneValue{{Key: "base_denom", Value: "uatom"}}, true},
+		{"invalid base denom", []*types.UpdateZoneValue{{Key: "base_denom", Value: "1"}}, false},
+		{"multi send", []*types.UpdateZoneValue{{Key: "multi_send", Value: "true"}}, true},
+		{"invalid liquidity module", []*types.UpdateZoneValue{{Key: "liquidity_module", Value: "yes please"}}, false},
+		{"account prefix", []*types.UpdateZoneValue{{Key: "account_prefix", Value: "cosmos"}}, true},
+		{"short account prefix", []*types.UpdateZoneValue{{Key: "account_prefix", Value: "c"}}, false},
+		{"connection id", []*types.UpdateZoneValue{{Key: "connection_id", Value: "connection-1"}}, false},
+		{"holdings allocation", []*types.UpdateZoneValue{{Key: "holdings_allocation", Value: "1000uqck"}}, true},
+		{"invalid validator selection allocation", []*types.UpdateZoneValue{{Key: "validator_selection_allocation", Value: "qck"}}, false},
+		{"commission rate", []*types.UpdateZoneValue{{Key: "commission_rate", Value: "0.05"}}, true},
+		{"commission rate above one", []*types.UpdateZoneValue{{Key: "commission_rate", Value: "1.5"}}, false},
+		{"state", []*types.UpdateZoneValue{{Key: "state", Value: "deposits_paused"}}, true},
+		{"unknown state", []*types.UpdateZoneValue{{Key: "state", Value: "halted"}}, false},
+		{"validator denylist", []*types.UpdateZoneValue{{Key: "validator_denylist", Value: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0,cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"}}, true},
+		{"empty validator allowlist", []*types.UpdateZoneValue{{Key: "validator_allowlist", Value: ""}}, true},
+		{"invalid validator denylist", []*types.UpdateZoneValue{{Key: "validator_denylist", Value: "notavaloper"}}, false},
+		{"duplicate validator allowlist", []*types.UpdateZoneValue{{Key: "validator_allowlist", Value: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0,cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"}}, false},
+		{"delegation account count", []*types.UpdateZoneValue{{Key: "delegation_account_count", Value: "15"}}, true},
+		{"zero delegation account count", []*types.UpdateZoneValue{{Key: "delegation_account_count", Value: "0"}}, false},
+		{"unknown key", []*types.UpdateZoneValue{{Key: "base_denom", Value: "uatom"}, {Key: "local_denom", Value: "uqatom"}}, false},
+		{"no changes", []*types.UpdateZoneValue{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.NewUpdateZoneProposal("title", "description", "cosmoshub-4", tt.changes).ValidateBasic()
+			if tt.valid && err != nil {
+				t.Errorf("expected valid proposal, got %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Errorf("expected invalid proposal")
+			}
+		})
+	}
+}
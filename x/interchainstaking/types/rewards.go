@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DetermineRewardsAllocation allocates rewards between delegation accounts in proportion to the deficit of their
+// delegated balances against the total delegated balance, so that the balances even out over time. Accounts are
+// allocated equally if no account has a deficit. Allocations are truncated, and accounts allocated nothing are
+// omitted; the undistributed remainder is returned as dust.
+//
+// Weighting by deficit (total - balance) is linear, rather than inversely proportional (1 / balance) to the delegated
+// balance: an inverse weight is undefined for an account with no delegated balance, such as one newly registered, and
+// as a balance approaches zero its account would take almost all of the rewards. A deficit weight is defined for
+// every balance, and is bounded by the total delegated balance, while still allocating most to the emptiest accounts.
+func DetermineRewardsAllocation(accounts []*ICAAccount, rewards sdk.Coin) (Allocations, sdk.Int) {
+	out := Allocations{}
+	if len(accounts) == 0 || !rewards.IsPositive() {
+		return out, rewards.Amount
+	}
+
+	balance := func(account *ICAAccount) sdk.Int {
+		if account.DelegatedBalance.Amount.IsNil() {
+			return sdk.ZeroInt()
+		}
+		return account.DelegatedBalance.Amount
+	}
+
+	total := sdk.ZeroInt()
+	for _, account := range accounts {
+		total = total.Add(balance(account))
+	}
+
+	weights := make([]sdk.Int, len(accounts))
+	totalWeight := sdk.ZeroInt()
+	for i, account := range accounts {
+		weights[i] = total.Sub(balance(account))
+		totalWeight = totalWeight.Add(weights[i])
+	}
+	// a single account, or accounts without balances, have no deficit.
+	if totalWeight.IsZero() {
+		for i := range weights {
+			weights[i] = sdk.OneInt()
+		}
+		totalWeight = sdk.NewInt(int64(len(weights)))
+	}
+
+	dust := rewards.Amount
+	for i, account := range accounts {
+		amount := rewards.Amount.Mul(weights[i]).Quo(totalWeight)
+		if !amount.IsPositive() {
+			continue
+		}
+		out = out.Allocate(account.Address, sdk.Coins{sdk.NewCoin(rewards.Denom, amount)})
+		dust = dust.Sub(amount)
+	}
+
+	return out, dust
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
+)
+
+func TestDetermineRewardsAllocation(t *testing.T) {
+	accounts := []*types.ICAAccount{
+		{Address: "cosmos1wdjkuer9wgkkzerywfjhxuedvfuhgetnlp6anv", DelegatedBalance: sdk.NewCoin("uatom", sdk.NewInt(100))},
+		{Address: "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a", DelegatedBalance: sdk.NewCoin("uatom", sdk.NewInt(300))},
+		{Address: "cosmos1ssrxxe4xsls57ehrkswlkhlkcverf0p0fpgyhzqw0hfdqj92ynxsw29r6e", DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())},
+	}
+
+	allocations, dust := types.DetermineRewardsAllocation(accounts, sdk.NewCoin("uatom", sdk.NewInt(1000)))
+	require.Len(t, allocations, 3)
+
+	// allocations are proportional to the deficit of delegated balance against the total (300, 100 and 400 of 800).
+	require.Equal(t, sdk.NewInt(375), allocations.Get(accounts[0].Address).Amount.AmountOf("uatom"))
+	require.Equal(t, sdk.NewInt(125), allocations.Get(accounts[1].Address).Amount.AmountOf("uatom"))
+	require.Equal(t, sdk.NewInt(500), allocations.Get(accounts[2].Address).Amount.AmountOf("uatom"))
+	require.True(t, dust.IsZero())
+
+	// truncated remainder is returned as dust.
+	allocations, dust = types.DetermineRewardsAllocation(accounts, sdk.NewCoin("uatom", sdk.NewInt(1001)))
+	require.Equal(t, sdk.NewInt(375), allocations.Get(accounts[0].Address).Amount.AmountOf("uatom"))
+	require.Equal(t, sdk.NewInt(1), dust)
+	require.Equal(t, sdk.NewInt(1001), allocations.Sum().AmountOf("uatom").Add(dust))
+
+	// equal balances receive equal allocations.
+	equal := []*types.ICAAccount{
+		{Address: accounts[0].Address, DelegatedBalance: sdk.NewCoin("uatom", sdk.NewInt(500))},
+		{Address: accounts[1].Address, DelegatedBalance: sdk.NewCoin("uatom", sdk.NewInt(500))},
+	}
+	allocations, dust = types.DetermineRewardsAllocation(equal, sdk.NewCoin("uatom", sdk.NewInt(101)))
+	require.Equal(t, sdk.NewInt(50), allocations.Get(equal[0].Address).Amount.AmountOf("uatom"))
+	require.Equal(t, sdk.NewInt(50), allocations.Get(equal[1].Address).Amount.AmountOf("uatom"))
+	require.Equal(t, sdk.OneInt(), dust)
+
+	// rewards too small to allocate are carried forward as dust in their entirety.
+	allocations, dust = types.DetermineRewardsAllocation(accounts, sdk.NewCoin("uatom", sdk.OneInt()))
+	require.Len(t, allocations, 0)
+	require.Equal(t, sdk.OneInt(), dust)
+
+	// a single account, or accounts without balances, are allocated equally.
+	allocations, dust = types.DetermineRewardsAllocation(equal[:1], sdk.NewCoin("uatom", sdk.NewInt(101)))
+	require.Equal(t, sdk.NewInt(101), allocations.Get(equal[0].Address).Amount.AmountOf("uatom"))
+	require.True(t, dust.IsZero())
+	empty := []*types.ICAAccount{
+		{Address: accounts[0].Address, DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())},
+		{Address: accounts[1].Address, DelegatedBalance: sdk.NewCoin("uatom", sdk.ZeroInt())},
+	}
+	allocations, dust = types.DetermineRewardsAllocation(empty, sdk.NewCoin("uatom", sdk.NewInt(100)))
+	require.Equal(t, sdk.NewInt(50), allocations.Get(empty[0].Address).Amount.AmountOf("uatom"))
+	require.Equal(t, sdk.NewInt(50), allocations.Get(empty[1].Address).Amount.AmountOf("uatom"))
+	require.True(t, dust.IsZero())
+
+	// no accounts.
+	allocations, dust = types.DetermineRewardsAllocation(nil, sdk.NewCoin("uatom", sdk.NewInt(1000)))
+	require.Len(t, allocations, 0)
+	require.Equal(t, sdk.NewInt(1000), dust)
+}
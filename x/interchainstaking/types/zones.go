@@ -1,9 +1,9 @@
 package types
 
 import (
-	"encoding/base64"
 	fmt "fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +31,54 @@ func (z *RegisteredZone) GetValidatorByValoper(valoper string) (*Validator, erro
 	return nil, fmt.Errorf("invalid validator -> %s", valoper)
 }
 
+// IsValidatorExcluded returns true if the validator is unknown, denylisted, absent from a non-empty allowlist, or
+// jailed or tombstoned on the host chain. Excluded validators receive no intents or new delegations.
+func (z *RegisteredZone) IsValidatorExcluded(valoper string) bool {
+	for _, denied := range z.ValidatorDenylist {
+		if denied == valoper {
+			return true
+		}
+	}
+
+	if len(z.ValidatorAllowlist) > 0 {
+		allowed := false
+		for _, v := range z.ValidatorAllowlist {
+			if v == valoper {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return true
+		}
+	}
+
+	val, err := z.GetValidatorByValoper(valoper)
+	if err != nil {
+		return true
+	}
+	return val.Jailed || val.Tombstoned
+}
+
+// FilterExcludedIntents returns the intents of validators that are not excluded, with weights normalised to sum to
+// one. If every validator is excluded, no intents are returned.
+func (z *RegisteredZone) FilterExcludedIntents(intents ValidatorIntents) ValidatorIntents {
+	out := ValidatorIntents{}
+	sum := sdk.ZeroDec()
+	for _, key := range intents.Keys() {
+		if z.IsValidatorExcluded(key) || !intents[key].Weight.IsPositive() {
+			continue
+		}
+		out[key] = &ValidatorIntent{ValoperAddress: key, Weight: intents[key].Weight}
+		sum = sum.Add(intents[key].Weight)
+	}
+
+	for _, key := range out.Keys() {
+		out[key].Weight = out[key].Weight.Quo(sum)
+	}
+	return out
+}
+
 func (z *RegisteredZone) GetDelegationAccountByAddress(address string) (*ICAAccount, error) {
 	if z.DelegationAddresses == nil {
 		return nil, fmt.Errorf("no delegation accounts set: %v", z)
@@ -43,6 +91,102 @@ func (z *RegisteredZone) GetDelegationAccountByAddress(address string) (*ICAAcco
 	return nil, fmt.Errorf("unable to find delegation account: %s", address)
 }
 
+// GetICAAccounts returns all registered interchain accounts of the zone.
+func (z *RegisteredZone) GetICAAccounts() []*ICAAccount {
+	accounts := []*ICAAccount{}
+	for _, account := range []*ICAAccount{z.DepositAddress, z.WithdrawalAddress, z.PerformanceAddress} {
+		if account != nil {
+			accounts = append(accounts, account)
+		}
+	}
+	return append(accounts, z.DelegationAddresses...)
+}
+
+func (z *RegisteredZone) GetICAAccountByPortName(portName string) (*ICAAccount, error) {
+	for _, account := range z.GetICAAccounts() {
+		if account.PortName == portName {
+			return account, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to find account for port: %s", portName)
+}
+
+// ValidateRedemptionRate checks a new redemption rate against the zone's bounds; the relative change from the
+// current rate, and the absolute floor and ceiling. Unset (zero) bounds are not enforced.
+func (z *RegisteredZone) ValidateRedemptionRate(rate sdk.Dec) error {
+	if isSetDec(z.MaxRedemptionRateChange) && isSetDec(z.RedemptionRate) {
+		change := rate.Sub(z.RedemptionRate).Abs().Quo(z.RedemptionRate)
+		if change.GT(z.MaxRedemptionRateChange) {
+			return fmt.Errorf("redemption rate change of %s exceeds maximum of %s", change, z.MaxRedemptionRateChange)
+		}
+	}
+
+	if isSetDec(z.MinRedemptionRate) && rate.LT(z.MinRedemptionRate) {
+		return fmt.Errorf("redemption rate %s is below minimum of %s", rate, z.MinRedemptionRate)
+	}
+
+	if isSetDec(z.MaxRedemptionRate) && rate.GT(z.MaxRedemptionRate) {
+		return fmt.Errorf("redemption rate %s is above maximum of %s", rate, z.MaxRedemptionRate)
+	}
+
+	return nil
+}
+
+func isSetDec(d sdk.Dec) bool {
+	return !d.IsNil() && d.IsPositive()
+}
+
+// zoneStateNames are the names used to set zone states by governance, and in events.
+var zoneStateNames = map[ZoneState]string{
+	ZoneStateActive:            "active",
+	ZoneStateDepositsPaused:    "deposits_paused",
+	ZoneStateRedemptionsPaused: "redemptions_paused",
+	ZoneStatePaused:            "paused",
+}
+
+// ZoneStateName returns the name of the zone state, as used by governance.
+func ZoneStateName(state ZoneState) string {
+	if name, ok := zoneStateNames[state]; ok {
+		return name
+	}
+	return state.String()
+}
+
+// ParseZoneState returns the zone state for the given name.
+func ParseZoneState(name string) (ZoneState, error) {
+	for state, stateName := range zoneStateNames {
+		if stateName == name {
+			return state, nil
+		}
+	}
+	return ZoneStateActive, fmt.Errorf("unknown zone state %q", name)
+}
+
+// DepositsEnabled returns true if the zone accepts deposits in its current state.
+func (z *RegisteredZone) DepositsEnabled() bool {
+	return z.State == ZoneStateActive || z.State == ZoneStateRedemptionsPaused
+}
+
+// RedemptionsEnabled returns true if the zone processes redemptions in its current state.
+func (z *RegisteredZone) RedemptionsEnabled() bool {
+	return z.State == ZoneStateActive || z.State == ZoneStateDepositsPaused
+}
+
+// IsPaused returns true if all operations of the zone are paused.
+func (z *RegisteredZone) IsPaused() bool {
+	return z.State == ZoneStatePaused
+}
+
+// IsAvailable returns false if any of the zone's interchain accounts is awaiting channel re-opening.
+func (z *RegisteredZone) IsAvailable() bool {
+	for _, account := range z.GetICAAccounts() {
+		if account.Unavailable {
+			return false
+		}
+	}
+	return true
+}
+
 func (z *RegisteredZone) ValidateCoinsForZone(ctx sdk.Context, coins sdk.Coins) error {
 	zoneVals := z.GetValidatorsAddressesAsSlice()
 
@@ -87,41 +231,18 @@ COINS:
 	return out
 }
 
-func (z *RegisteredZone) ConvertMemoToOrdinalIntents(coins sdk.Coins, memo string) ValidatorIntents {
-	// should we be return DelegatorIntent here?
-	out := make(ValidatorIntents)
-
-	if len(memo) == 0 {
-		return out
-	}
-
-	memoBytes, err := base64.StdEncoding.DecodeString(memo)
-	if err != nil {
-		fmt.Println("unable to determine intent from memo: Failed to decode base64 message", err)
-		return out
-	}
-
-	if len(memoBytes)%21 != 0 { // memo must be one byte (1-200) weight then 20 byte valoperAddress
-		fmt.Println("unable to determine intent from memo: Message was incorrect length", len(memoBytes))
-		return out
-	}
-
-	for index := 0; index < len(memoBytes); {
-		sdkWeight := sdk.NewDecFromInt(sdk.NewInt(int64(memoBytes[index]))).QuoInt(sdk.NewInt(200))
-		coinWeight := sdkWeight.MulInt(coins.AmountOf(z.BaseDenom))
-		index++
-		address := memoBytes[index : index+20]
-		index += 20
-		valAddr, _ := bech32.ConvertAndEncode(z.AccountPrefix+"valoper", address)
-
-		val, ok := out[valAddr]
-		if !ok {
-			val = &ValidatorIntent{ValoperAddress: valAddr, Weight: sdk.ZeroDec()}
+// ValidateValoperAddresses returns an error if any of the addresses is not a validator operator address of the zone.
+func (z *RegisteredZone) ValidateValoperAddresses(addresses []string) error {
+	for _, address := range addresses {
+		hrp, _, err := bech32.DecodeAndConvert(address)
+		if err != nil {
+			return fmt.Errorf("invalid validator address %s: %w", address, err)
+		}
+		if hrp != z.AccountPrefix+"valoper" {
+			return fmt.Errorf("validator address %s does not have the prefix %svaloper of zone %s", address, z.AccountPrefix, z.ChainId)
 		}
-		val.Weight = val.Weight.Add(coinWeight)
-		out[valAddr] = val
 	}
-	return out
+	return nil
 }
 
 func (z *RegisteredZone) GetValidatorsSorted() []*Validator {
@@ -158,17 +279,40 @@ func (z *RegisteredZone) GetDelegationAccounts() []*ICAAccount {
 	return delegationAccounts
 }
 
+// GetActiveDelegationAccounts returns the delegation accounts that are not retired, sorted by address.
+func (z *RegisteredZone) GetActiveDelegationAccounts() []*ICAAccount {
+	accounts := []*ICAAccount{}
+	for _, account := range z.GetDelegationAccounts() {
+		if !account.Retired {
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts
+}
+
+// IsRetiredDelegationIndex returns true if the delegation account of the given index is beyond the zone's delegation
+// account count. Zones without a count retire no accounts.
+func (z *RegisteredZone) IsRetiredDelegationIndex(index int) bool {
+	return z.DelegationAccountCount > 0 && index >= int(z.DelegationAccountCount)
+}
+
+// GetAggregateIntentOrDefault returns the aggregate intent of the zone, excluding ineligible validators, or the
+// default intent if no eligible validators remain.
 func (z *RegisteredZone) GetAggregateIntentOrDefault() ValidatorIntents {
-	if len(z.AggregateIntent) == 0 {
+	intents := z.FilterExcludedIntents(z.AggregateIntent)
+	if len(intents) == 0 {
 		return z.DefaultAggregateIntents()
 	}
-	return z.AggregateIntent
+	return intents
 }
 
 // defaultAggregateIntents determines the default aggregate intent (for epoch 0)
 func (z *RegisteredZone) DefaultAggregateIntents() ValidatorIntents {
 	out := make(ValidatorIntents)
 	for _, val := range z.GetValidatorsSorted() {
+		if z.IsValidatorExcluded(val.GetValoperAddress()) {
+			continue
+		}
 		if val.CommissionRate.LTE(sdk.NewDecWithPrec(5, 1)) { // 50%; make this a param.
 			out[val.GetValoperAddress()] = &ValidatorIntent{ValoperAddress: val.GetValoperAddress(), Weight: sdk.OneDec()}
 		}
@@ -186,3 +330,12 @@ func (z *RegisteredZone) DefaultAggregateIntents() ValidatorIntents {
 
 	return out
 }
+
+// DelegationIndex returns the index of a delegation account, from the suffix of its port name.
+func (a *ICAAccount) DelegationIndex() (int, error) {
+	parts := strings.Split(a.PortName, ".")
+	if len(parts) < 3 || parts[len(parts)-2] != "delegate" {
+		return 0, fmt.Errorf("not a delegation account port: %s", a.PortName)
+	}
+	return strconv.Atoi(parts[len(parts)-1])
+}
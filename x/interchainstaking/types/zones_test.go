@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 
 	"github.com/ingenuity-build/quicksilver/x/interchainstaking/types"
 )
@@ -24,6 +25,40 @@ func TestDefaultIntent(t *testing.T) {
 	}
 }
 
+func TestExcludedValidators(t *testing.T) {
+	zone := types.RegisteredZone{ConnectionId: "connection-0", ChainId: "cosmoshub-4", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
+	zone.Validators = append(zone.Validators, &types.Validator{ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", CommissionRate: sdk.MustNewDecFromStr("0.2"), VotingPower: sdk.NewInt(2000)})
+	zone.Validators = append(zone.Validators, &types.Validator{ValoperAddress: "cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf", CommissionRate: sdk.MustNewDecFromStr("0.2"), VotingPower: sdk.NewInt(2000), Jailed: true})
+	zone.Validators = append(zone.Validators, &types.Validator{ValoperAddress: "cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy", CommissionRate: sdk.MustNewDecFromStr("0.2"), VotingPower: sdk.NewInt(2000), Tombstoned: true})
+	zone.Validators = append(zone.Validators, &types.Validator{ValoperAddress: "cosmosvaloper1a3yjj7d3qnx4spgvjcwjq9cw9snrrrhu5h6jll", CommissionRate: sdk.MustNewDecFromStr("0.2"), VotingPower: sdk.NewInt(2000)})
+	zone.ValidatorDenylist = []string{"cosmosvaloper1a3yjj7d3qnx4spgvjcwjq9cw9snrrrhu5h6jll"}
+
+	require.False(t, zone.IsValidatorExcluded("cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"))
+	require.True(t, zone.IsValidatorExcluded("cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"))
+	require.True(t, zone.IsValidatorExcluded("cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy"))
+	require.True(t, zone.IsValidatorExcluded("cosmosvaloper1a3yjj7d3qnx4spgvjcwjq9cw9snrrrhu5h6jll"))
+	require.True(t, zone.IsValidatorExcluded("cosmosvaloper1z8zjv3lntpwxua0rtpvgrcwl0nm0tltgpgs6l7"))
+
+	out := zone.DefaultAggregateIntents()
+	require.Len(t, out, 1)
+	require.Equal(t, sdk.OneDec(), out["cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"].Weight)
+
+	intents := types.ValidatorIntents{
+		"cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0": {ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", Weight: sdk.NewDecWithPrec(25, 2)},
+		"cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf": {ValoperAddress: "cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf", Weight: sdk.NewDecWithPrec(75, 2)},
+	}
+	filtered := zone.FilterExcludedIntents(intents)
+	require.Len(t, filtered, 1)
+	require.Equal(t, sdk.OneDec(), filtered["cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"].Weight)
+	// the input intents are unchanged.
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), intents["cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"].Weight)
+
+	// an allowlist excludes every validator not on it.
+	zone.ValidatorAllowlist = []string{"cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf"}
+	require.True(t, zone.IsValidatorExcluded("cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0"))
+	require.Empty(t, zone.DefaultAggregateIntents())
+}
+
 func TestCoinsToIntent(t *testing.T) {
 	zone := types.RegisteredZone{ConnectionId: "connection-0", ChainId: "cosmoshub-4", AccountPrefix: "cosmos", LocalDenom: "uqatom", BaseDenom: "uatom"}
 	zone.Validators = append(zone.Validators, &types.Validator{ValoperAddress: "cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", CommissionRate: sdk.MustNewDecFromStr("0.2"), VotingPower: sdk.NewInt(2000)})
@@ -129,7 +164,10 @@ func TestBase64MemoToIntent(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		out := zone.ConvertMemoToOrdinalIntents(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(int64(tc.amount)))), tc.memo)
+		memo, err := zone.ParseMemo(tc.memo)
+		require.NoError(t, err)
+		out := memo.ToOrdinalIntents(sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(int64(tc.amount)))), zone.BaseDenom)
+		require.Len(t, out, len(tc.expectedIntent))
 		for k, v := range out {
 			if !tc.expectedIntent[k].Equal(v.Weight) {
 				t.Errorf("Got %v expected %v", v.Weight, tc.expectedIntent[k])
@@ -242,3 +280,36 @@ func TestBase64MemoToIntent(t *testing.T) {
 // 	}
 
 // }
+
+func TestValidateRedemptionRate(t *testing.T) {
+	zone := types.RegisteredZone{ChainId: "cosmoshub-4", RedemptionRate: sdk.NewDec(1)}
+
+	// no bounds set
+	if err := zone.ValidateRedemptionRate(sdk.NewDecWithPrec(5, 1)); err != nil {
+		t.Errorf("expected no error without bounds, got %v", err)
+	}
+
+	zone.MaxRedemptionRateChange = sdk.NewDecWithPrec(1, 1)
+	zone.MinRedemptionRate = sdk.NewDecWithPrec(95, 2)
+	zone.MaxRedemptionRate = sdk.NewDecWithPrec(105, 2)
+
+	tests := []struct {
+		rate  sdk.Dec
+		valid bool
+	}{
+		{sdk.NewDecWithPrec(102, 2), true},
+		{sdk.NewDecWithPrec(96, 2), true},
+		{sdk.NewDecWithPrec(85, 2), false},  // change > 10%
+		{sdk.NewDecWithPrec(94, 2), false},  // below floor
+		{sdk.NewDecWithPrec(106, 2), false}, // above ceiling
+	}
+	for _, tt := range tests {
+		err := zone.ValidateRedemptionRate(tt.rate)
+		if tt.valid && err != nil {
+			t.Errorf("expected rate %s to be valid, got %v", tt.rate, err)
+		}
+		if !tt.valid && err == nil {
+			t.Errorf("expected rate %s to be invalid", tt.rate)
+		}
+	}
+}